@@ -12,6 +12,7 @@ const (
 	DoorSound
 	DeathSound
 	DashSound
+	GeigerClickSound
 )
 
 // AudioSystem manages all game sounds
@@ -22,6 +23,7 @@ type AudioSystem struct {
 	DoorSound       rl.Sound
 	DeathSound      rl.Sound
 	DashSound       rl.Sound
+	GeigerClick     rl.Sound
 	BackgroundMusic rl.Music
 }
 
@@ -36,6 +38,7 @@ func NewAudioSystem() *AudioSystem {
 		DoorSound:   GenerateSound(600, 0.3, 0.8),   // 600 Hz for door unlock
 		DeathSound:  GenerateSound(200, 0.5, 0.9),   // 200 Hz for death
 		DashSound:   GenerateSound(1200, 0.1, 0.7),  // 1200 Hz for dash
+		GeigerClick: GenerateSound(2400, 0.01, 0.9), // 10 ms tick for the Geiger counter
 	}
 	
 	// Set the volume for all sounds
@@ -45,6 +48,7 @@ func NewAudioSystem() *AudioSystem {
 	rl.SetSoundVolume(system.DoorSound, 0.8)
 	rl.SetSoundVolume(system.DeathSound, 0.8)
 	rl.SetSoundVolume(system.DashSound, 0.7)
+	rl.SetSoundVolume(system.GeigerClick, 0.5)
 	
 	// Try to load background music if available
 	// system.BackgroundMusic = rl.LoadMusicStream("assets/background_music.mp3")
@@ -69,6 +73,8 @@ func (as *AudioSystem) PlaySound(soundType int) {
 		rl.PlaySound(as.DeathSound)
 	case DashSound:
 		rl.PlaySound(as.DashSound)
+	case GeigerClickSound:
+		rl.PlaySound(as.GeigerClick)
 	}
 }
 
@@ -88,6 +94,7 @@ func (as *AudioSystem) Cleanup() {
 	rl.UnloadSound(as.DoorSound)
 	rl.UnloadSound(as.DeathSound)
 	rl.UnloadSound(as.DashSound)
+	rl.UnloadSound(as.GeigerClick)
 	
 	// Unload music if loaded
 	if as.BackgroundMusic.CtxData != nil {
@@ -45,6 +45,12 @@ func generateWavData(frequency float32, duration float32, sampleRate int, volume
 	attackSamples := int(attackTime * float32(sampleRate))
 	releaseSamples := int(releaseTime * float32(sampleRate))
 	
+	// Sounds shorter than the attack, like clicks, get a sharp attack and decay instead
+	if sampleCount <= attackSamples {
+		attackSamples = sampleCount / 10
+		releaseSamples = sampleCount - attackSamples
+	}
+	
 	// Generate and write the sample data (int16 samples).
 	for i := 0; i < sampleCount; i++ {
 		// Apply envelope
@@ -0,0 +1,178 @@
+// components/active_effects.go
+package components
+
+import (
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// EffectType identifies a timed power-up effect
+type EffectType int
+
+const (
+    EffectSpeedBoost EffectType = iota
+    EffectRapidFire
+    EffectShield
+    EffectMagnet
+    EffectDoubleScore
+)
+
+// StackRule says what happens when an effect is picked up while it is still active
+type StackRule int
+
+const (
+    StackRefresh   StackRule = iota // Reset the timer to the full duration
+    StackExtend                     // Add the duration to the time left, up to MaxDuration
+    StackIntensify                  // Add a stack, up to MaxStacks, and reset the timer
+)
+
+// EffectDef holds the data that defines a timed effect
+type EffectDef struct {
+    Name        string
+    Duration    float32 // Seconds a single pickup lasts
+    Stack       StackRule
+    MaxDuration float32 // Cap on the time left for StackExtend
+    MaxStacks   int     // Cap on stacks for StackIntensify
+    Magnitude   float32 // Strength of the effect per stack; meaning depends on the effect
+    Color       rl.Color
+}
+
+// EffectDefs is the definition of every timed effect
+var EffectDefs = map[EffectType]EffectDef{
+    EffectSpeedBoost: {
+        Name:      "SPEED",
+        Duration:  8,
+        Stack:     StackIntensify,
+        MaxStacks: 3,
+        Magnitude: 0.3, // Extra fraction of the player's speed
+        Color:     rl.Purple,
+    },
+    EffectRapidFire: {
+        Name:        "RAPID FIRE",
+        Duration:    8,
+        Stack:       StackExtend,
+        MaxDuration: 20,
+        Magnitude:   1.0, // Extra fraction of the weapon's fire rate
+        Color:       rl.Orange,
+    },
+    EffectShield: {
+        Name:      "SHIELD",
+        Duration:  10,
+        Stack:     StackRefresh,
+        Magnitude: 0, // Blocks all damage while active
+        Color:     rl.SkyBlue,
+    },
+    EffectMagnet: {
+        Name:      "MAGNET",
+        Duration:  12,
+        Stack:     StackRefresh,
+        Magnitude: 250, // Radius pickups are pulled in from
+        Color:     rl.Gold,
+    },
+    EffectDoubleScore: {
+        Name:        "2X SCORE",
+        Duration:    10,
+        Stack:       StackExtend,
+        MaxDuration: 30,
+        Magnitude:   2, // Score multiplier
+        Color:       rl.Green,
+    },
+}
+
+// ActiveEffect is one running timed effect
+type ActiveEffect struct {
+    Type      EffectType
+    Remaining float32 // Seconds left
+    Duration  float32 // Seconds the timer was last set to, for HUD bars
+    Stacks    int
+}
+
+// ActiveEffects component holds the timed effects currently running on an entity
+type ActiveEffects struct {
+    Effects map[EffectType]*ActiveEffect
+    id      ComponentID
+}
+
+// NewActiveEffects creates a new ActiveEffects component with no effects running
+func NewActiveEffects(registry *ComponentTypeRegistry) *ActiveEffects {
+    id, _ := registry.GetID("ActiveEffects")
+    return &ActiveEffects{
+        Effects: make(map[EffectType]*ActiveEffect),
+        id:      id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (a *ActiveEffects) GetComponentID() ComponentID {
+    return a.id
+}
+
+// Apply starts an effect, or follows the effect's stacking rule if it is already running.
+// A duration of 0 uses the effect's default duration.
+func (a *ActiveEffects) Apply(effectType EffectType, duration float32) {
+    def := EffectDefs[effectType]
+    if duration <= 0 {
+        duration = def.Duration
+    }
+
+    effect, running := a.Effects[effectType]
+    if !running {
+        a.Effects[effectType] = &ActiveEffect{
+            Type:      effectType,
+            Remaining: duration,
+            Duration:  duration,
+            Stacks:    1,
+        }
+        return
+    }
+
+    switch def.Stack {
+    case StackRefresh:
+        effect.Remaining = duration
+        effect.Duration = duration
+    case StackExtend:
+        effect.Remaining += duration
+        if def.MaxDuration > 0 && effect.Remaining > def.MaxDuration {
+            effect.Remaining = def.MaxDuration
+        }
+        if effect.Remaining > effect.Duration {
+            effect.Duration = effect.Remaining
+        }
+    case StackIntensify:
+        if effect.Stacks < def.MaxStacks {
+            effect.Stacks++
+        }
+        effect.Remaining = duration
+        effect.Duration = duration
+    }
+}
+
+// Update counts down every effect and removes those that ran out
+func (a *ActiveEffects) Update(dt float32) {
+    for effectType, effect := range a.Effects {
+        effect.Remaining -= dt
+        if effect.Remaining <= 0 {
+            delete(a.Effects, effectType)
+        }
+    }
+}
+
+// Has reports whether an effect is running. A nil component has no effects.
+func (a *ActiveEffects) Has(effectType EffectType) bool {
+    if a == nil {
+        return false
+    }
+    _, running := a.Effects[effectType]
+    return running
+}
+
+// Magnitude returns an effect's total strength, or 0 if it isn't running
+func (a *ActiveEffects) Magnitude(effectType EffectType) float32 {
+    if a == nil {
+        return 0
+    }
+    effect, running := a.Effects[effectType]
+    if !running {
+        return 0
+    }
+    return EffectDefs[effectType].Magnitude * float32(effect.Stacks)
+}
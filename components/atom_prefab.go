@@ -0,0 +1,149 @@
+// components/atom_prefab.go
+package components
+
+import (
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AtomBehavior identifies how an enemy moves on its own
+type AtomBehavior int
+
+const (
+    BehaviorNone   AtomBehavior = iota // Moved by another system (e.g. the boss AI)
+    BehaviorDrift                      // Wander slowly, never faster than its speed
+    BehaviorHoming                     // Steer toward the player when close, up to a speed cap
+    BehaviorOrbit                      // Circle the player when close
+)
+
+// AtomPrefab holds the data that defines an enemy type
+type AtomPrefab struct {
+    Behavior    AtomBehavior
+    Tier        int     // Size class used by fusion; higher tiers are bigger and tougher
+    Radius      float32 // Collider radius
+    Health      int
+    SpeedFactor float32 // Multiplier applied to the level's base speed
+    SpinSpeed   float32 // Radians per second
+    Tint        rl.Color
+    SpriteScale float32 // 0 keeps the sprite's default scale
+    Electrons   int     // Electron shells that must be knocked off before the nucleus takes damage
+    Radiation   float32 // Dose per second given to a player right next to the atom
+
+    // Homing and orbiting only kick in when the player is within this distance
+    EngageRadius float32
+
+    // Homing: acceleration toward the player and maximum speed as a multiple of Speed
+    HomingStrength float32
+    MaxSpeedFactor float32
+
+    // Orbiting: preferred distance from the player and how fast to circle it
+    OrbitRadius float32
+    OrbitSpeed  float32
+
+    // Drifting: how much the heading can change per second (radians)
+    WanderRate float32
+
+    // Any behavior: back away from the player when it gets closer than this (0 = never)
+    FleeRadius float32
+
+    // Fission: the atom splits into SplitMin..SplitMax atoms of type SplitInto when destroyed
+    SplitInto EnemyType
+    SplitMin  int
+    SplitMax  int
+}
+
+// AtomPrefabs is the prefab data for every enemy type
+var AtomPrefabs = map[EnemyType]AtomPrefab{
+    NormalAtom: {
+        Behavior:     BehaviorOrbit,
+        Tier:         2,
+        Radius:       15,
+        Health:       2,
+        SpeedFactor:  1.0,
+        SpinSpeed:    2.0,
+        Tint:         rl.White,
+        Radiation:    1.5,
+        EngageRadius: 180,
+        OrbitRadius:  120,
+        OrbitSpeed:   100,
+        SplitInto:    FastAtom,
+        SplitMin:     2,
+        SplitMax:     2,
+    },
+    FastAtom: {
+        Behavior:       BehaviorHoming,
+        Tier:           1,
+        Radius:         12,
+        Health:         1,
+        SpeedFactor:    1.5,
+        SpinSpeed:      4.0,
+        Tint:           rl.Orange,
+        Radiation:      1.0,
+        EngageRadius:   200,
+        HomingStrength: 50,
+        MaxSpeedFactor: 1.2,
+    },
+    BigAtom: {
+        Behavior:    BehaviorDrift,
+        Tier:        3,
+        Electrons:   2,
+        Radius:      25,
+        Health:      4,
+        SpeedFactor: 0.7,
+        SpinSpeed:   1.0,
+        Tint:        rl.Maroon,
+        Radiation:   2.5,
+        WanderRate:  0.6,
+        FleeRadius:  140,
+        SplitInto:   NormalAtom,
+        SplitMin:    2,
+        SplitMax:    3,
+    },
+    HeavyAtom: {
+        Behavior:    BehaviorDrift,
+        Tier:        4,
+        Electrons:   3,
+        Radius:      32,
+        Health:      8,
+        SpeedFactor: 0.5,
+        SpinSpeed:   0.8,
+        Tint:        rl.Purple,
+        Radiation:   4.0,
+        SpriteScale: 1.3,
+        WanderRate:  0.4,
+        SplitInto:   BigAtom,
+        SplitMin:    2,
+        SplitMax:    2,
+    },
+    SuperheavyAtom: {
+        Behavior:    BehaviorDrift,
+        Tier:        5,
+        Electrons:   4,
+        Radius:      40,
+        Health:      14,
+        SpeedFactor: 0.4,
+        SpinSpeed:   0.6,
+        Tint:        rl.DarkPurple,
+        Radiation:   6.0,
+        SpriteScale: 1.6,
+        WanderRate:  0.3,
+        SplitInto:   HeavyAtom,
+        SplitMin:    2,
+        SplitMax:    2,
+    },
+    Boss: {
+        Behavior:    BehaviorNone,
+        Radius:      40,
+        Health:      100,
+        SpeedFactor: 1.0,
+        SpinSpeed:   0.5,
+        Tint:        rl.Red,
+    },
+}
+
+// GetAtomPrefab returns the prefab for an enemy type, falling back to NormalAtom
+func GetAtomPrefab(enemyType EnemyType) AtomPrefab {
+    if prefab, ok := AtomPrefabs[enemyType]; ok {
+        return prefab
+    }
+    return AtomPrefabs[NormalAtom]
+}
@@ -0,0 +1,67 @@
+// components/boss.go
+package components
+
+import (
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// BossPhase represents the boss's current attack pattern
+type BossPhase int
+
+const (
+    BossCircling   BossPhase = iota // Circle around the arena
+    BossTelegraph                   // Hover in place and show where the next dash will go
+    BossDashing                     // Quick dash toward the telegraphed target
+    BossFiring                      // Slow down and fire at the player
+)
+
+// BossAI component holds the state of the boss's attack state machine
+type BossAI struct {
+    Phase          BossPhase
+    PhaseTimer     float32 // Time left in the current phase
+    AttackTimer    float32 // Time until the next shot or dash
+    Stage          int     // Increases as health drops below thresholds (0 = full health)
+    CircleCenter   rl.Vector2
+    CircleRadius   float32
+    CircleAngle    float32
+    DashTarget     rl.Vector2 // Where the current dash is headed (shown during the telegraph)
+    DashesLeft     int        // Dashes remaining in the current dashing phase
+    Invulnerable   bool
+    InvTimer       float32
+    FlashTimer     float32 // Brief white flash after taking a hit
+    RotorAngle     float32
+    id             ComponentID
+}
+
+// NewBossAI creates a new BossAI component that starts circling the given point
+func NewBossAI(circleCenter rl.Vector2, registry *ComponentTypeRegistry) *BossAI {
+    id, _ := registry.GetID("BossAI")
+    return &BossAI{
+        Phase:        BossCircling,
+        PhaseTimer:   6.0,
+        AttackTimer:  0,
+        Stage:        0,
+        CircleCenter: circleCenter,
+        CircleRadius: 200,
+        CircleAngle:  0,
+        DashesLeft:   0,
+        Invulnerable: true, // Start invulnerable while the boss flies in
+        InvTimer:     2.0,
+        FlashTimer:   0,
+        RotorAngle:   0,
+        id:           id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (b *BossAI) GetComponentID() ComponentID {
+    return b.id
+}
+
+// MakeInvulnerable starts an invulnerability window of the given length
+func (b *BossAI) MakeInvulnerable(duration float32) {
+    b.Invulnerable = true
+    if duration > b.InvTimer {
+        b.InvTimer = duration
+    }
+}
@@ -0,0 +1,24 @@
+// components/cascade.go
+package components
+
+// Cascade component ties a projectile to the player's shot that started a chain reaction
+type Cascade struct {
+    Origin EntityID // The player's shot the chain reaction started from
+    Depth  int      // 0 for the shot itself, +1 for each generation of neutrons
+    id     ComponentID
+}
+
+// NewCascade creates a new Cascade component
+func NewCascade(origin EntityID, depth int, registry *ComponentTypeRegistry) *Cascade {
+    id, _ := registry.GetID("Cascade")
+    return &Cascade{
+        Origin: origin,
+        Depth:  depth,
+        id:     id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (c *Cascade) GetComponentID() ComponentID {
+    return c.id
+}
@@ -0,0 +1,24 @@
+// components/decay.go
+package components
+
+// DecayRule describes how an unstable atom type decays
+type DecayRule struct {
+    Into        EnemyType // Atom type left behind after the decay
+    HalfLife    float32   // Seconds until half of a group of these atoms would have decayed
+    PulseRadius float32   // Reach of the radiation pulse released by the decay
+    PulseDamage int       // Damage the pulse deals to everything in reach
+}
+
+// DecayChains lists every unstable atom type. Types that aren't listed are stable.
+// Slow heavy atoms decay down the chain into smaller, faster and more aggressive ones.
+var DecayChains = map[EnemyType]DecayRule{
+    SuperheavyAtom: {Into: HeavyAtom, HalfLife: 20, PulseRadius: 150, PulseDamage: 2},
+    HeavyAtom:      {Into: BigAtom, HalfLife: 30, PulseRadius: 120, PulseDamage: 1},
+    BigAtom:        {Into: FastAtom, HalfLife: 45, PulseRadius: 100, PulseDamage: 1},
+}
+
+// GetDecayRule returns the decay rule for an atom type, if it is unstable
+func GetDecayRule(enemyType EnemyType) (DecayRule, bool) {
+    rule, ok := DecayChains[enemyType]
+    return rule, ok
+}
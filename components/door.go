@@ -0,0 +1,24 @@
+// components/door.go
+package components
+
+// Door component marks a level exit and tracks whether it can be used
+type Door struct {
+    Unlocked      bool // True once the level objectives have been met
+    PlayerReached bool // Set by the collision system when the player touches an unlocked door
+    id            ComponentID
+}
+
+// NewDoor creates a new locked Door component
+func NewDoor(registry *ComponentTypeRegistry) *Door {
+    id, _ := registry.GetID("Door")
+    return &Door{
+        Unlocked:      false,
+        PlayerReached: false,
+        id:            id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (d *Door) GetComponentID() ComponentID {
+    return d.id
+}
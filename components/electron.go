@@ -0,0 +1,39 @@
+// components/electron.go
+package components
+
+// Electron component makes an entity an electron shell orbiting an atom.
+// Each active electron absorbs one hit before the atom's nucleus can be damaged.
+type Electron struct {
+    Parent      EntityID // The atom this electron orbits
+    OrbitRadius float32
+    OrbitSpeed  float32 // Radians per second
+    Angle       float32
+    Active      bool    // False after being knocked off, until it regenerates
+    RegenTimer  float32 // Time left before a knocked off electron comes back
+    id          ComponentID
+}
+
+// NewElectron creates a new Electron component
+func NewElectron(parent EntityID, orbitRadius, orbitSpeed, angle float32, registry *ComponentTypeRegistry) *Electron {
+    id, _ := registry.GetID("Electron")
+    return &Electron{
+        Parent:      parent,
+        OrbitRadius: orbitRadius,
+        OrbitSpeed:  orbitSpeed,
+        Angle:       angle,
+        Active:      true,
+        RegenTimer:  0,
+        id:          id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (e *Electron) GetComponentID() ComponentID {
+    return e.id
+}
+
+// KnockOff strips the electron from its atom until it regenerates
+func (e *Electron) KnockOff(regenDelay float32) {
+    e.Active = false
+    e.RegenTimer = regenDelay
+}
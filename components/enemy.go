@@ -9,43 +9,68 @@ const (
     FastAtom
     BigAtom
     Boss
+    HeavyAtom      // Only created by fusion
+    SuperheavyAtom // Only created by fusion
 )
 
 // Enemy component contains enemy-specific properties
 type Enemy struct {
-    Type       EnemyType
-    Speed      float32
-    FireRate   float32
-    SpinSpeed  float32
-    Rotation   float32
-    id         ComponentID
+    Type           EnemyType
+    Speed          float32
+    FireRate       float32
+    SpinSpeed      float32
+    Rotation       float32
+    SplitInto      EnemyType     // Type of fragment spawned when destroyed
+    SplitMin       int           // Fewest fragments spawned on death (0 = doesn't split)
+    SplitMax       int           // Most fragments spawned on death
+    SpawnTimer     float32       // Invulnerable while fragments fly apart
+    Chain          *FissionChain // Shared by every atom split from the same original (nil until it splits)
+    HalfLife       float32       // Isotope half-life in seconds (0 = stable)
+    DecayTimer     float32       // Seconds until the atom decays, once scheduled
+    DecayScheduled bool          // Set once the DecaySystem has drawn a decay time
+    id             ComponentID
+}
+
+// FissionChain tracks the atoms created by splitting one original atom, so the
+// chain's score can be held back until every fragment has been destroyed
+type FissionChain struct {
+    Remaining int // Atoms in the chain still alive
+    Points    int // Points banked from destroyed atoms
 }
 
 // NewEnemy creates a new Enemy component
 func NewEnemy(enemyType EnemyType, speed float32, registry *ComponentTypeRegistry) *Enemy {
     id, _ := registry.GetID("Enemy")
-    
-    spinSpeed := float32(2.0)
-    switch enemyType {
-    case FastAtom:
-        spinSpeed = 4.0
-    case BigAtom:
-        spinSpeed = 1.0
-    case Boss:
-        spinSpeed = 0.5
-    }
+    prefab := GetAtomPrefab(enemyType)
+    decay, _ := GetDecayRule(enemyType)
     
     return &Enemy{
-        Type:      enemyType,
-        Speed:     speed,
-        FireRate:  0,
-        SpinSpeed: spinSpeed,
-        Rotation:  0,
-        id:        id,
+        Type:       enemyType,
+        Speed:      speed,
+        FireRate:   0,
+        SpinSpeed:  prefab.SpinSpeed,
+        Rotation:   0,
+        SplitInto:  prefab.SplitInto,
+        SplitMin:   prefab.SplitMin,
+        SplitMax:   prefab.SplitMax,
+        SpawnTimer: 0,
+        Chain:      nil,
+        HalfLife:   decay.HalfLife,
+        id:         id,
     }
 }
 
 // GetComponentID returns the component's unique ID
 func (e *Enemy) GetComponentID() ComponentID {
     return e.id
-}
\ No newline at end of file
+}
+
+// IsSpawning reports whether the enemy was just split off and can't be hurt yet
+func (e *Enemy) IsSpawning() bool {
+    return e.SpawnTimer > 0
+}
+
+// IsAboutToDecay reports whether the atom will decay within the warning time
+func (e *Enemy) IsAboutToDecay(warningTime float32) bool {
+    return e.DecayScheduled && e.DecayTimer <= warningTime
+}
@@ -45,6 +45,13 @@ func (m *EntityManager) DestroyEntity(entityID EntityID) {
     delete(m.entities, entityID)
 }
 
+// DestroyAllEntities removes every entity and component, e.g. when tearing down a level
+func (m *EntityManager) DestroyAllEntities() {
+    for entityID := range m.entities {
+        m.DestroyEntity(entityID)
+    }
+}
+
 // AddComponent adds a component to an entity
 func (m *EntityManager) AddComponent(entityID EntityID, component Component) {
     if !m.entities[entityID] {
@@ -0,0 +1,46 @@
+// components/faction.go
+package components
+
+// FactionType identifies which side an entity (usually a projectile) fights for
+type FactionType int
+
+const (
+    PlayerFaction FactionType = iota // Fired by the player, hurts enemies
+    EnemyFaction                     // Fired by enemies, hurts the player and scientists
+    NeutronFaction                   // Emitted by destroyed atoms, hurts other atoms only
+)
+
+// Faction component decides what a projectile is allowed to hit
+type Faction struct {
+    Type  FactionType
+    Owner EntityID // Entity that fired the projectile (0 if unknown)
+    id    ComponentID
+}
+
+// NewFaction creates a new Faction component
+func NewFaction(factionType FactionType, owner EntityID, registry *ComponentTypeRegistry) *Faction {
+    id, _ := registry.GetID("Faction")
+    return &Faction{
+        Type:  factionType,
+        Owner: owner,
+        id:    id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (f *Faction) GetComponentID() ComponentID {
+    return f.id
+}
+
+// CanDamage reports whether a projectile of this faction hurts entities with the given tag
+func (t FactionType) CanDamage(target TagType) bool {
+    switch t {
+    case PlayerFaction:
+        return target == EnemyTag || target == BossTag || target == ElectronTag
+    case EnemyFaction:
+        return target == PlayerTag || target == ScientistTag
+    case NeutronFaction:
+        return target == EnemyTag || target == ElectronTag
+    }
+    return false
+}
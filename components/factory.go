@@ -8,13 +8,15 @@ import (
 
 // EntityFactory provides convenience functions for creating common game entities
 type EntityFactory struct {
-    registry *ComponentTypeRegistry
-    manager  *EntityManager
+    registry    *ComponentTypeRegistry
+    manager     *EntityManager
+    scaleHealth func(int) int // Scales atom health, e.g. for the difficulty (nil = unscaled)
     assets   struct {
         playerSprite   rl.Texture2D
         enemySprite    rl.Texture2D
-        bulletSprite   rl.Texture2D
-        powerUpSprites [3]rl.Texture2D
+        bulletSprite      rl.Texture2D
+        enemyBulletSprite rl.Texture2D
+        powerUpSprites    [3]rl.Texture2D
     }
 }
 
@@ -22,7 +24,7 @@ type EntityFactory struct {
 func NewEntityFactory(
     registry *ComponentTypeRegistry,
     manager *EntityManager,
-    playerSprite, enemySprite, bulletSprite rl.Texture2D,
+    playerSprite, enemySprite, bulletSprite, enemyBulletSprite rl.Texture2D,
     powerUpSprites [3]rl.Texture2D,
 ) *EntityFactory {
     factory := &EntityFactory{
@@ -33,11 +35,17 @@ func NewEntityFactory(
     factory.assets.playerSprite = playerSprite
     factory.assets.enemySprite = enemySprite
     factory.assets.bulletSprite = bulletSprite
+    factory.assets.enemyBulletSprite = enemyBulletSprite
     factory.assets.powerUpSprites = powerUpSprites
     
     return factory
 }
 
+// SetHealthScaler sets how the health of every atom the factory creates is scaled
+func (f *EntityFactory) SetHealthScaler(scale func(int) int) {
+    f.scaleHealth = scale
+}
+
 // CreatePlayer creates a player entity
 func (f *EntityFactory) CreatePlayer(x, y float32, hasGun bool) EntityID {
     // Create player entity
@@ -51,50 +59,80 @@ func (f *EntityFactory) CreatePlayer(x, y float32, hasGun bool) EntityID {
     f.manager.AddComponent(playerID, NewHealth(3, 10, f.registry))
     f.manager.AddComponent(playerID, NewTag(PlayerTag, f.registry))
     
-    player := NewPlayer(300, f.registry)
-    player.HasGun = hasGun
-    f.manager.AddComponent(playerID, player)
+    f.manager.AddComponent(playerID, NewPlayer(300, f.registry))
+    
+    weapon := NewWeapon(f.registry)
+    if hasGun {
+        weapon.Grant(WeaponBlaster)
+    }
+    f.manager.AddComponent(playerID, weapon)
+    f.manager.AddComponent(playerID, NewActiveEffects(f.registry))
     
     return playerID
 }
 
 // CreateAtom creates an enemy atom entity
 func (f *EntityFactory) CreateAtom(x, y float32, velX, velY float32, atomType EnemyType, level int) EntityID {
-    // Create atom entity
-    atomID := f.manager.CreateEntity()
-    
     // Get speed based on level and type
+    prefab := GetAtomPrefab(atomType)
     baseSpeed := float32(100 + level*10)
-    speed := baseSpeed + float32(rl.GetRandomValue(-20, 20))
+    speed := (baseSpeed + float32(rl.GetRandomValue(-20, 20))) * prefab.SpeedFactor
     
-    if atomType == FastAtom {
-        speed *= 1.5
-    } else if atomType == BigAtom {
-        speed *= 0.7
+    return f.CreateAtomWithSpeed(x, y, velX, velY, atomType, speed)
+}
+
+// CreateAtomWithSpeed creates an enemy atom entity with an explicit speed, e.g. a fission fragment
+func (f *EntityFactory) CreateAtomWithSpeed(x, y float32, velX, velY float32, atomType EnemyType, speed float32) EntityID {
+    // Create atom entity
+    atomID := f.manager.CreateEntity()
+    prefab := GetAtomPrefab(atomType)
+    
+    sprite := NewSprite(f.assets.enemySprite, f.registry)
+    sprite.Tint = prefab.Tint
+    if prefab.SpriteScale > 0 {
+        sprite.Scale = prefab.SpriteScale
     }
     
     // Add components
     f.manager.AddComponent(atomID, NewPosition(x, y, f.registry))
     f.manager.AddComponent(atomID, NewVelocity(velX, velY, f.registry))
-    f.manager.AddComponent(atomID, NewSprite(f.assets.enemySprite, f.registry))
+    f.manager.AddComponent(atomID, sprite)
     f.manager.AddComponent(atomID, NewTag(EnemyTag, f.registry))
     f.manager.AddComponent(atomID, NewEnemy(atomType, speed, f.registry))
     
-    // Add health and collider based on type
-    if atomType == NormalAtom {
-        f.manager.AddComponent(atomID, NewCircleCollider(15, f.registry))
-        f.manager.AddComponent(atomID, NewHealth(2, 2, f.registry))
-    } else if atomType == FastAtom {
-        f.manager.AddComponent(atomID, NewCircleCollider(12, f.registry))
-        f.manager.AddComponent(atomID, NewHealth(1, 1, f.registry))
-    } else if atomType == BigAtom {
-        f.manager.AddComponent(atomID, NewCircleCollider(25, f.registry))
-        f.manager.AddComponent(atomID, NewHealth(4, 4, f.registry))
+    // Add health and collider from the prefab
+    f.manager.AddComponent(atomID, NewCircleCollider(prefab.Radius, f.registry))
+    health := prefab.Health
+    if f.scaleHealth != nil {
+        health = f.scaleHealth(health)
     }
+    f.manager.AddComponent(atomID, NewHealth(health, health, f.registry))
+    
+    f.CreateElectronShells(atomID, x, y, prefab.Electrons, prefab.Radius)
     
     return atomID
 }
 
+// CreateElectronShells creates count electrons orbiting the given atom, each on its own shell
+func (f *EntityFactory) CreateElectronShells(atomID EntityID, x, y float32, count int, nucleusRadius float32) {
+    for i := 0; i < count; i++ {
+        orbitRadius := nucleusRadius + constants.ElectronOrbitGap + float32(i)*constants.ElectronShellGap
+        
+        // Spread electrons around the atom and alternate their direction
+        angle := float32(i) * 2.4
+        orbitSpeed := float32(constants.ElectronOrbitSpeed)
+        if i%2 == 1 {
+            orbitSpeed = -orbitSpeed
+        }
+        
+        electronID := f.manager.CreateEntity()
+        f.manager.AddComponent(electronID, NewPosition(x, y, f.registry))
+        f.manager.AddComponent(electronID, NewCircleCollider(constants.ElectronRadius, f.registry))
+        f.manager.AddComponent(electronID, NewTag(ElectronTag, f.registry))
+        f.manager.AddComponent(electronID, NewElectron(atomID, orbitRadius, orbitSpeed, angle, f.registry))
+    }
+}
+
 // CreateBoss creates a boss entity
 func (f *EntityFactory) CreateBoss(x, y float32) EntityID {
     // Create boss entity
@@ -108,26 +146,63 @@ func (f *EntityFactory) CreateBoss(x, y float32) EntityID {
     f.manager.AddComponent(bossID, NewHealth(100, 100, f.registry))
     f.manager.AddComponent(bossID, NewTag(BossTag, f.registry))
     f.manager.AddComponent(bossID, NewEnemy(Boss, 200, f.registry))
+    f.manager.AddComponent(bossID, NewBossAI(rl.Vector2{X: x, Y: y}, f.registry))
     
     return bossID
 }
 
-// CreateBullet creates a bullet entity
+// CreateBullet creates a bullet entity. Enemy bullets use their own sprite, size and
+// lifetime and belong to the enemy faction, so they only hurt the player and scientists.
 func (f *EntityFactory) CreateBullet(x, y float32, velX, velY float32, isEnemyBullet bool) EntityID {
     // Create bullet entity
     bulletID := f.manager.CreateEntity()
     
+    sprite := f.assets.bulletSprite
+    prefab := WeaponDefs[WeaponBlaster].Projectile
+    faction := PlayerFaction
+    if isEnemyBullet {
+        sprite = f.assets.enemyBulletSprite
+        prefab = EnemyBulletPrefab
+        faction = EnemyFaction
+    }
+    
     // Add components
     f.manager.AddComponent(bulletID, NewPosition(x, y, f.registry))
     f.manager.AddComponent(bulletID, NewVelocity(velX, velY, f.registry))
-    f.manager.AddComponent(bulletID, NewCircleCollider(5, f.registry))
-    f.manager.AddComponent(bulletID, NewSprite(f.assets.bulletSprite, f.registry))
+    f.manager.AddComponent(bulletID, NewCircleCollider(prefab.Radius, f.registry))
+    f.manager.AddComponent(bulletID, NewSprite(sprite, f.registry))
     f.manager.AddComponent(bulletID, NewTag(BulletTag, f.registry))
-    f.manager.AddComponent(bulletID, NewLifetime(constants.BulletLifetime, f.registry))
+    f.manager.AddComponent(bulletID, NewFaction(faction, 0, f.registry))
+    f.manager.AddComponent(bulletID, NewProjectile(prefab, f.registry))
+    f.manager.AddComponent(bulletID, NewLifetime(prefab.Lifetime, f.registry))
+    
+    // Player shots can start chain reactions
+    if !isEnemyBullet {
+        f.manager.AddComponent(bulletID, NewCascade(bulletID, 0, f.registry))
+    }
     
     return bulletID
 }
 
+// CreateNeutron creates a short-lived neutron emitted by a destroyed atom.
+// It carries on the chain reaction started by the origin shot.
+func (f *EntityFactory) CreateNeutron(x, y float32, velX, velY float32, origin EntityID, depth int) EntityID {
+    // Create neutron entity
+    neutronID := f.manager.CreateEntity()
+    
+    // Add components
+    f.manager.AddComponent(neutronID, NewPosition(x, y, f.registry))
+    f.manager.AddComponent(neutronID, NewVelocity(velX, velY, f.registry))
+    f.manager.AddComponent(neutronID, NewCircleCollider(NeutronPrefab.Radius, f.registry))
+    f.manager.AddComponent(neutronID, NewTag(BulletTag, f.registry))
+    f.manager.AddComponent(neutronID, NewFaction(NeutronFaction, 0, f.registry))
+    f.manager.AddComponent(neutronID, NewCascade(origin, depth, f.registry))
+    f.manager.AddComponent(neutronID, NewProjectile(NeutronPrefab, f.registry))
+    f.manager.AddComponent(neutronID, NewLifetime(NeutronPrefab.Lifetime, f.registry))
+    
+    return neutronID
+}
+
 // CreateScientist creates a scientist entity
 func (f *EntityFactory) CreateScientist(x, y float32) EntityID {
     // Create scientist entity
@@ -165,6 +240,7 @@ func (f *EntityFactory) CreateDoor(x, y, width, height float32) EntityID {
     f.manager.AddComponent(doorID, NewPosition(x, y, f.registry))
     f.manager.AddComponent(doorID, NewRectangleCollider(width, height, f.registry))
     f.manager.AddComponent(doorID, NewTag(DoorTag, f.registry))
+    f.manager.AddComponent(doorID, NewDoor(f.registry))
     
     return doorID
 }
@@ -181,15 +257,20 @@ func (f *EntityFactory) CreatePowerUp(x, y float32, powerUpType PowerUpType) Ent
     // Select the appropriate sprite based on power-up type
     spriteIndex := 0
     switch powerUpType {
-    case PowerUpGun:
+    case PowerUpWeapon:
         spriteIndex = 0
     case PowerUpHealth:
         spriteIndex = 1
-    case PowerUpSpeed:
+    default:
         spriteIndex = 2
     }
     
-    f.manager.AddComponent(powerUpID, NewSprite(f.assets.powerUpSprites[spriteIndex], f.registry))
+    // Timed effects share the speed sprite, tinted with the effect's color
+    sprite := NewSprite(f.assets.powerUpSprites[spriteIndex], f.registry)
+    if effectType, timed := powerUpType.Effect(); timed && powerUpType != PowerUpSpeed {
+        sprite.Tint = EffectDefs[effectType].Color
+    }
+    f.manager.AddComponent(powerUpID, sprite)
     f.manager.AddComponent(powerUpID, NewTag(PowerUpTag, f.registry))
     
     // Create power-up component with appropriate values
@@ -197,18 +278,19 @@ func (f *EntityFactory) CreatePowerUp(x, y float32, powerUpType PowerUpType) Ent
     var value float32 = 0
     
     switch powerUpType {
-    case PowerUpGun:
-        // Gun is permanent
+    case PowerUpWeapon:
+        // Weapons are permanent
         duration = 0
         value = 0
     case PowerUpHealth:
         // Health is instant
         duration = 0
         value = 1
-    case PowerUpSpeed:
-        // Speed is permanent
-        duration = 0
-        value = 50
+    default:
+        // Timed effects last their default duration
+        if effectType, timed := powerUpType.Effect(); timed {
+            duration = EffectDefs[effectType].Duration
+        }
     }
     
     f.manager.AddComponent(powerUpID, NewPowerUp(powerUpType, duration, value, f.registry))
@@ -216,6 +298,20 @@ func (f *EntityFactory) CreatePowerUp(x, y float32, powerUpType PowerUpType) Ent
     return powerUpID
 }
 
+// CreateWeaponPowerUp creates a power-up that grants or upgrades a weapon, tinted like the weapon's shots
+func (f *EntityFactory) CreateWeaponPowerUp(x, y float32, weaponType WeaponType) EntityID {
+    powerUpID := f.CreatePowerUp(x, y, PowerUpWeapon)
+    
+    if powerUpComp, has := f.manager.GetComponent(powerUpID, f.registry.GetIDByName("PowerUp")); has {
+        powerUpComp.(*PowerUp).Weapon = weaponType
+    }
+    if spriteComp, has := f.manager.GetComponent(powerUpID, f.registry.GetIDByName("Sprite")); has && weaponType != WeaponBlaster {
+        spriteComp.(*Sprite).Tint = GetWeaponDef(weaponType, 1).Projectile.Tint
+    }
+    
+    return powerUpID
+}
+
 // CreateParticle creates a particle entity
 func (f *EntityFactory) CreateParticle(x, y float32, velX, velY float32, lifetime float32, size float32, color rl.Color) EntityID {
     // Create particle entity
@@ -0,0 +1,32 @@
+// components/fusion.go
+package components
+
+// FusionRule says which atom two atoms fuse into when they collide fast enough
+type FusionRule struct {
+    A, B             EnemyType
+    Result           EnemyType
+    MinRelativeSpeed float32 // Slower contacts just pass through each other
+}
+
+// FusionRules lists every allowed fusion. Order of A and B doesn't matter.
+var FusionRules = []FusionRule{
+    {A: FastAtom, B: FastAtom, Result: NormalAtom, MinRelativeSpeed: 260},
+    {A: NormalAtom, B: FastAtom, Result: BigAtom, MinRelativeSpeed: 220},
+    {A: NormalAtom, B: NormalAtom, Result: BigAtom, MinRelativeSpeed: 200},
+    {A: BigAtom, B: FastAtom, Result: HeavyAtom, MinRelativeSpeed: 200},
+    {A: BigAtom, B: NormalAtom, Result: HeavyAtom, MinRelativeSpeed: 180},
+    {A: BigAtom, B: BigAtom, Result: HeavyAtom, MinRelativeSpeed: 160},
+    {A: HeavyAtom, B: NormalAtom, Result: SuperheavyAtom, MinRelativeSpeed: 180},
+    {A: HeavyAtom, B: BigAtom, Result: SuperheavyAtom, MinRelativeSpeed: 160},
+    {A: HeavyAtom, B: HeavyAtom, Result: SuperheavyAtom, MinRelativeSpeed: 140},
+}
+
+// FindFusionRule returns the rule for fusing two atom types, if there is one
+func FindFusionRule(a, b EnemyType) (FusionRule, bool) {
+    for _, rule := range FusionRules {
+        if (rule.A == a && rule.B == b) || (rule.A == b && rule.B == a) {
+            return rule, true
+        }
+    }
+    return FusionRule{}, false
+}
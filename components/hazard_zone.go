@@ -0,0 +1,24 @@
+// components/hazard_zone.go
+package components
+
+// HazardZone marks a contaminated area that irradiates the player while inside it
+type HazardZone struct {
+    Radius   float32
+    DoseRate float32 // Dose per second while the player is inside
+    id       ComponentID
+}
+
+// NewHazardZone creates a new HazardZone component
+func NewHazardZone(radius, doseRate float32, registry *ComponentTypeRegistry) *HazardZone {
+    id, _ := registry.GetID("HazardZone")
+    return &HazardZone{
+        Radius:   radius,
+        DoseRate: doseRate,
+        id:       id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (h *HazardZone) GetComponentID() ComponentID {
+    return h.id
+}
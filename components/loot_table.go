@@ -0,0 +1,77 @@
+// components/loot_table.go
+package components
+
+// LootEntry is one power-up a loot table can drop
+type LootEntry struct {
+    PowerUp     PowerUpType
+    Weight      int // Relative chance against the other entries in the table
+    MaxPerLevel int // Most of this power-up dropped per level, from all tables (0 = no limit)
+}
+
+// LootTable describes what is dropped when a table is rolled
+type LootTable struct {
+    MinLevel   int           // First level the table is used on
+    DropChance float32       // Chance that a roll drops one of the weighted entries
+    PityAfter  int           // Rolls without a drop after which the next roll always drops (0 = no pity)
+    Guaranteed []PowerUpType // Dropped on every roll, on top of the weighted entry
+    Entries    []LootEntry
+}
+
+// Shared entries, so every table treats a power-up the same way
+var (
+    healthLoot      = LootEntry{PowerUp: PowerUpHealth, Weight: 30, MaxPerLevel: 3}
+    weaponLoot      = LootEntry{PowerUp: PowerUpWeapon, Weight: 10, MaxPerLevel: 1}
+    speedLoot       = LootEntry{PowerUp: PowerUpSpeed, Weight: 20}
+    rapidFireLoot   = LootEntry{PowerUp: PowerUpRapidFire, Weight: 20}
+    shieldLoot      = LootEntry{PowerUp: PowerUpShield, Weight: 10, MaxPerLevel: 2}
+    magnetLoot      = LootEntry{PowerUp: PowerUpMagnet, Weight: 15}
+    doubleScoreLoot = LootEntry{PowerUp: PowerUpDoubleScore, Weight: 10, MaxPerLevel: 2}
+)
+
+// EnemyLoot lists the loot tables of each enemy type, in order of MinLevel. Atoms split
+// into fragments that can each drop loot, so their chances are kept low.
+var EnemyLoot = map[EnemyType][]LootTable{
+    NormalAtom: {
+        {MinLevel: 1, DropChance: 0.05, PityAfter: 25, Entries: []LootEntry{healthLoot, speedLoot, rapidFireLoot}},
+        {MinLevel: 3, DropChance: 0.06, PityAfter: 20, Entries: []LootEntry{healthLoot, speedLoot, rapidFireLoot, magnetLoot, shieldLoot}},
+    },
+    FastAtom: {
+        {MinLevel: 1, DropChance: 0.06, PityAfter: 25, Entries: []LootEntry{speedLoot, rapidFireLoot, magnetLoot}},
+        {MinLevel: 3, DropChance: 0.08, PityAfter: 20, Entries: []LootEntry{speedLoot, rapidFireLoot, magnetLoot, doubleScoreLoot}},
+    },
+    BigAtom: {
+        {MinLevel: 1, DropChance: 0.1, PityAfter: 15, Entries: []LootEntry{healthLoot, rapidFireLoot, shieldLoot, weaponLoot}},
+        {MinLevel: 4, DropChance: 0.12, PityAfter: 12, Entries: []LootEntry{healthLoot, rapidFireLoot, shieldLoot, weaponLoot, doubleScoreLoot}},
+    },
+    HeavyAtom: {
+        {MinLevel: 1, DropChance: 0.25, PityAfter: 6, Entries: []LootEntry{healthLoot, shieldLoot, weaponLoot, doubleScoreLoot}},
+    },
+    SuperheavyAtom: {
+        {MinLevel: 1, DropChance: 0.5, PityAfter: 3, Entries: []LootEntry{shieldLoot, weaponLoot, doubleScoreLoot}},
+    },
+    Boss: {
+        {MinLevel: 1, DropChance: 1, Guaranteed: []PowerUpType{PowerUpHealth}, Entries: []LootEntry{weaponLoot, shieldLoot, doubleScoreLoot}},
+    },
+}
+
+// LevelStartLoot is rolled once when a normal level starts, in order of MinLevel
+var LevelStartLoot = []LootTable{
+    {MinLevel: 1, DropChance: 0.6, Entries: []LootEntry{{PowerUp: PowerUpHealth, Weight: 60}, speedLoot}},
+    {MinLevel: 2, DropChance: 0.2, Guaranteed: []PowerUpType{PowerUpHealth}, Entries: []LootEntry{speedLoot, rapidFireLoot, shieldLoot, magnetLoot, doubleScoreLoot}},
+}
+
+// BossLevelStartLoot is rolled once when a boss level starts, in order of MinLevel
+var BossLevelStartLoot = []LootTable{
+    {MinLevel: 1, DropChance: 0.5, Guaranteed: []PowerUpType{PowerUpHealth}, Entries: []LootEntry{speedLoot, rapidFireLoot, shieldLoot}},
+}
+
+// GetLootTable returns the last table in a list that applies to the level, or nil if none does
+func GetLootTable(tables []LootTable, level int) *LootTable {
+    var table *LootTable
+    for i := range tables {
+        if tables[i].MinLevel <= level {
+            table = &tables[i]
+        }
+    }
+    return table
+}
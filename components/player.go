@@ -1,28 +1,96 @@
 // components/player.go
 package components
 
+import (
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
 // Player component contains player-specific properties
 type Player struct {
-    Speed     float32
-    HasGun    bool
-    IsDashing bool
-    DashTimer float32
-    id        ComponentID
+    Speed          float32
+    IsDashing      bool
+    DashTimer      float32    // Time left in the current dash
+    DashCooldown   float32    // Time until the player can dash again
+    DashRecharge   float32    // Length of the current cooldown, for showing its progress
+    DashDirection  rl.Vector2 // Unit direction of the current dash
+    Facing         rl.Vector2 // Unit direction the player last moved in
+    InvulnTimer    float32    // Time left of invulnerability after being hit
+    RadiationDose  float32    // Builds up near atoms and in hazard zones
+    DoseDrainTimer float32    // Time since radiation sickness last cost health
+    id             ComponentID
 }
 
 // NewPlayer creates a new Player component
 func NewPlayer(speed float32, registry *ComponentTypeRegistry) *Player {
     id, _ := registry.GetID("Player")
     return &Player{
-        Speed:     speed,
-        HasGun:    false,
-        IsDashing: false,
-        DashTimer: 0,
-        id:        id,
+        Speed:          speed,
+        IsDashing:      false,
+        DashTimer:      0,
+        DashCooldown:   0,
+        Facing:         rl.Vector2{X: 1, Y: 0},
+        InvulnTimer:    0,
+        RadiationDose:  0,
+        DoseDrainTimer: 0,
+        id:             id,
     }
 }
 
 // GetComponentID returns the component's unique ID
 func (p *Player) GetComponentID() ComponentID {
     return p.id
-}
\ No newline at end of file
+}
+
+// CanDash reports whether the player can start a dash
+func (p *Player) CanDash() bool {
+    return !p.IsDashing && p.DashCooldown <= 0
+}
+
+// StartDash starts a dash in the given direction, or the way the player is facing if it is zero
+func (p *Player) StartDash(direction rl.Vector2, duration float32) {
+    if direction.X == 0 && direction.Y == 0 {
+        direction = p.Facing
+    }
+    p.IsDashing = true
+    p.DashTimer = duration
+    p.DashDirection = rl.Vector2Normalize(direction)
+}
+
+// MakeInvulnerable keeps the player from taking damage for at least the given time
+func (p *Player) MakeInvulnerable(duration float32) {
+    if duration > p.InvulnTimer {
+        p.InvulnTimer = duration
+    }
+}
+
+// IsInvulnerable reports whether the player can't take damage right now. Dashing
+// through danger is safe, as is the moment right after being hit.
+func (p *Player) IsInvulnerable() bool {
+    return p.IsDashing || p.InvulnTimer > 0
+}
+
+// UpdateTimers counts down the dash and invulnerability timers. The dash cooldown
+// starts once the dash itself is over.
+func (p *Player) UpdateTimers(dt float32, dashCooldown float32) {
+    if p.IsDashing {
+        p.DashTimer -= dt
+        if p.DashTimer <= 0 {
+            p.IsDashing = false
+            p.DashTimer = 0
+            p.DashCooldown = dashCooldown
+            p.DashRecharge = dashCooldown
+        }
+    } else if p.DashCooldown > 0 {
+        p.DashCooldown -= dt
+        if p.DashCooldown < 0 {
+            p.DashCooldown = 0
+        }
+    }
+
+    if p.InvulnTimer > 0 {
+        p.InvulnTimer -= dt
+        if p.InvulnTimer < 0 {
+            p.InvulnTimer = 0
+        }
+    }
+}
@@ -5,16 +5,21 @@ package components
 type PowerUpType int
 
 const (
-    PowerUpGun PowerUpType = iota
+    PowerUpWeapon PowerUpType = iota // Grants a weapon, or upgrades it if already owned
     PowerUpHealth
-    PowerUpSpeed
+    PowerUpSpeed // Timed speed boost
+    PowerUpRapidFire
+    PowerUpShield
+    PowerUpMagnet
+    PowerUpDoubleScore
 )
 
 // PowerUp component represents a power-up effect
 type PowerUp struct {
     Type     PowerUpType
-    Duration float32  // Duration in seconds (0 for permanent)
-    Value    float32  // Value of the power-up effect
+    Duration float32    // Duration in seconds (0 for permanent)
+    Value    float32    // Value of the power-up effect
+    Weapon   WeaponType // Weapon granted by a weapon power-up
     id       ComponentID
 }
 
@@ -32,4 +37,21 @@ func NewPowerUp(powerUpType PowerUpType, duration, value float32, registry *Comp
 // GetComponentID returns the component's unique ID
 func (p *PowerUp) GetComponentID() ComponentID {
     return p.id
-}
\ No newline at end of file
+}
+
+// Effect returns the timed effect a power-up starts, if it starts one
+func (t PowerUpType) Effect() (EffectType, bool) {
+    switch t {
+    case PowerUpSpeed:
+        return EffectSpeedBoost, true
+    case PowerUpRapidFire:
+        return EffectRapidFire, true
+    case PowerUpShield:
+        return EffectShield, true
+    case PowerUpMagnet:
+        return EffectMagnet, true
+    case PowerUpDoubleScore:
+        return EffectDoubleScore, true
+    }
+    return 0, false
+}
@@ -0,0 +1,54 @@
+// components/projectile.go
+package components
+
+import (
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Projectile component carries the behavior of a shot fired from a weapon
+type Projectile struct {
+    Damage   int
+    Pierce   int                   // Extra targets the shot can still pass through
+    Ricochet int                   // Bounces off the edge of the world left
+    TurnRate float32               // Radians per second the shot turns toward the nearest atom
+    Gravity  float32               // Downward pull in pixels per second squared
+    Tint     rl.Color              // Color used when the shot has no sprite
+    Hit      map[EntityID]struct{} // Targets already hit, so a piercing shot hits each only once
+    id       ComponentID
+}
+
+// NewProjectile creates a new Projectile component from a projectile prefab
+func NewProjectile(prefab ProjectilePrefab, registry *ComponentTypeRegistry) *Projectile {
+    id, _ := registry.GetID("Projectile")
+    return &Projectile{
+        Damage:   prefab.Damage,
+        Pierce:   prefab.Pierce,
+        Ricochet: prefab.Ricochet,
+        TurnRate: prefab.TurnRate,
+        Gravity:  prefab.Gravity,
+        Tint:     prefab.Tint,
+        Hit:      make(map[EntityID]struct{}),
+        id:       id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (p *Projectile) GetComponentID() ComponentID {
+    return p.id
+}
+
+// HasHit reports whether the projectile already hit a target
+func (p *Projectile) HasHit(target EntityID) bool {
+    _, hit := p.Hit[target]
+    return hit
+}
+
+// RegisterHit records a hit and reports whether the projectile keeps flying
+func (p *Projectile) RegisterHit(target EntityID) bool {
+    p.Hit[target] = struct{}{}
+    if p.Pierce > 0 {
+        p.Pierce--
+        return true
+    }
+    return false
+}
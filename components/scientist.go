@@ -21,6 +21,8 @@ type Scientist struct {
     WanderDir   rl.Vector2
     FollowOffset rl.Vector2
     AnimTimer   float32
+    PanicTimer  float32    // Time left running away from a nearby atom
+    FleeDir     rl.Vector2 // Direction the scientist is running while panicking
     id          ComponentID
 }
 
@@ -36,6 +38,8 @@ func NewScientist(registry *ComponentTypeRegistry) *Scientist {
             Y: float32(rl.GetRandomValue(-15, 15)),
         },
         AnimTimer:   float32(rl.GetRandomValue(0, 100)) / 100.0, // Random start time
+        PanicTimer:  0,
+        FleeDir:     rl.Vector2{X: 0, Y: 0},
         id:          id,
     }
 }
@@ -43,4 +47,9 @@ func NewScientist(registry *ComponentTypeRegistry) *Scientist {
 // GetComponentID returns the component's unique ID
 func (s *Scientist) GetComponentID() ComponentID {
     return s.id
+}
+
+// IsPanicking returns true while the scientist is fleeing from an atom
+func (s *Scientist) IsPanicking() bool {
+    return s.PanicTimer > 0
 }
\ No newline at end of file
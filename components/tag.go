@@ -13,6 +13,8 @@ const (
     RescueZoneTag
     DoorTag
     BossTag
+    ElectronTag
+    HazardZoneTag
 )
 
 // Tag component identifies the entity type
@@ -0,0 +1,83 @@
+// components/weapon.go
+package components
+
+import (
+    "atomblaster/constants"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Weapon component holds the weapons an entity owns, the one in use and its firing state
+type Weapon struct {
+    Levels     map[WeaponType]int // Upgrade level of every owned weapon
+    Current    WeaponType
+    Cooldown   float32    // Seconds until the trigger can be pulled again
+    BurstLeft  int        // Volleys of the current burst still to fire
+    BurstTimer float32    // Seconds until the next volley of the burst
+    Kick       rl.Vector2 // Recoil still pushing the owner back
+    id         ComponentID
+}
+
+// NewWeapon creates a new Weapon component that owns no weapons yet
+func NewWeapon(registry *ComponentTypeRegistry) *Weapon {
+    id, _ := registry.GetID("Weapon")
+    return &Weapon{
+        Levels:  make(map[WeaponType]int),
+        Current: WeaponBlaster,
+        id:      id,
+    }
+}
+
+// GetComponentID returns the component's unique ID
+func (w *Weapon) GetComponentID() ComponentID {
+    return w.id
+}
+
+// HasAny reports whether any weapon is owned
+func (w *Weapon) HasAny() bool {
+    return len(w.Levels) > 0
+}
+
+// Owns reports whether a weapon is owned
+func (w *Weapon) Owns(weaponType WeaponType) bool {
+    _, owned := w.Levels[weaponType]
+    return owned
+}
+
+// Grant gives a weapon, or upgrades it if it is already owned, and switches to it.
+// It returns the weapon's new level.
+func (w *Weapon) Grant(weaponType WeaponType) int {
+    level := w.Levels[weaponType] + 1
+    if level > constants.MaxWeaponLevel {
+        level = constants.MaxWeaponLevel
+    }
+    
+    w.Levels[weaponType] = level
+    w.Select(weaponType)
+    return level
+}
+
+// Select switches to an owned weapon, cancelling any burst in progress
+func (w *Weapon) Select(weaponType WeaponType) bool {
+    if !w.Owns(weaponType) || weaponType == w.Current {
+        return false
+    }
+    
+    w.Current = weaponType
+    w.BurstLeft = 0
+    return true
+}
+
+// Cycle switches to the next owned weapon
+func (w *Weapon) Cycle() {
+    for i := 1; i <= len(WeaponDefs); i++ {
+        next := WeaponType((int(w.Current) + i) % len(WeaponDefs))
+        if w.Select(next) {
+            return
+        }
+    }
+}
+
+// Definition returns the definition of the weapon in use at its upgrade level
+func (w *Weapon) Definition() WeaponDef {
+    return GetWeaponDef(w.Current, w.Levels[w.Current])
+}
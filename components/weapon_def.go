@@ -0,0 +1,169 @@
+// components/weapon_def.go
+package components
+
+import (
+    "atomblaster/constants"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// WeaponType identifies a weapon the player can own
+type WeaponType int
+
+const (
+    WeaponBlaster WeaponType = iota
+    WeaponSpread
+    WeaponLaser
+    WeaponHoming
+    WeaponBouncer
+)
+
+// ProjectilePrefab holds the data that defines the shots a weapon fires
+type ProjectilePrefab struct {
+    Speed    float32
+    Radius   float32
+    Lifetime float32 // Seconds before the shot fizzles out
+    Damage   int
+    Pierce   int     // Extra targets the shot passes through before it is spent
+    Ricochet int     // Times the shot bounces off the edge of the world before it leaves
+    TurnRate float32 // Radians per second the shot turns toward the nearest atom (0 = flies straight)
+    Gravity  float32 // Downward pull in pixels per second squared
+    Tint     rl.Color
+}
+
+// WeaponDef holds the data that defines a weapon
+type WeaponDef struct {
+    Name            string
+    FireRate        float32 // Trigger pulls per second
+    Projectile      ProjectilePrefab
+    ProjectileCount int     // Shots fired side by side with each pull
+    Spread          float32 // Angle in degrees covered by the side by side shots
+    BurstCount      int     // Volleys fired one after another with each pull
+    BurstInterval   float32 // Seconds between the volleys of a burst
+    Recoil          float32 // Speed the player is pushed back with on every volley
+
+    // Upgrading a weapon fires faster and adds shots
+    FireRatePerLevel float32 // Extra fraction of FireRate per level
+    CountPerLevel    int     // Extra side by side shots per level
+}
+
+// WeaponDefs is the definition of every weapon
+var WeaponDefs = map[WeaponType]WeaponDef{
+    WeaponBlaster: {
+        Name:     "BLASTER",
+        FireRate: 1 / constants.FireCooldownDuration,
+        Projectile: ProjectilePrefab{
+            Speed:    constants.BulletSpeed,
+            Radius:   5,
+            Lifetime: constants.BulletLifetime,
+            Damage:   10,
+            Tint:     rl.Yellow,
+        },
+        ProjectileCount:  1,
+        BurstCount:       1,
+        FireRatePerLevel: 0.25,
+    },
+    WeaponSpread: {
+        Name:     "SPREAD",
+        FireRate: 3,
+        Projectile: ProjectilePrefab{
+            Speed:    550,
+            Radius:   4,
+            Lifetime: 0.8,
+            Damage:   6,
+            Tint:     rl.Orange,
+        },
+        ProjectileCount:  5,
+        Spread:           40,
+        BurstCount:       1,
+        Recoil:           60,
+        FireRatePerLevel: 0.1,
+        CountPerLevel:    2,
+    },
+    WeaponLaser: {
+        Name:     "LASER",
+        FireRate: 8,
+        Projectile: ProjectilePrefab{
+            Speed:    1100,
+            Radius:   4,
+            Lifetime: 0.6,
+            Damage:   5,
+            Pierce:   3,
+            Tint:     rl.SkyBlue,
+        },
+        ProjectileCount:  1,
+        BurstCount:       1,
+        FireRatePerLevel: 0.2,
+    },
+    WeaponHoming: {
+        Name:     "HOMING",
+        FireRate: 1.5,
+        Projectile: ProjectilePrefab{
+            Speed:    350,
+            Radius:   6,
+            Lifetime: 3,
+            Damage:   15,
+            TurnRate: 4,
+            Tint:     rl.Magenta,
+        },
+        ProjectileCount:  1,
+        Spread:           30,
+        BurstCount:       3,
+        BurstInterval:    0.08,
+        Recoil:           40,
+        FireRatePerLevel: 0.15,
+        CountPerLevel:    1,
+    },
+    WeaponBouncer: {
+        Name:     "BOUNCER",
+        FireRate: 2.5,
+        Projectile: ProjectilePrefab{
+            Speed:    500,
+            Radius:   7,
+            Lifetime: 4,
+            Damage:   12,
+            Ricochet: 3,
+            Gravity:  120,
+            Tint:     rl.Lime,
+        },
+        ProjectileCount:  1,
+        Spread:           20,
+        BurstCount:       1,
+        Recoil:           30,
+        FireRatePerLevel: 0.2,
+        CountPerLevel:    1,
+    },
+}
+
+// EnemyBulletPrefab is the shot fired by enemies such as the boss
+var EnemyBulletPrefab = ProjectilePrefab{
+    Speed:    constants.EnemyBulletSpeed,
+    Radius:   constants.EnemyBulletRadius,
+    Lifetime: constants.EnemyBulletLifetime,
+    Damage:   1,
+    Tint:     rl.Red,
+}
+
+// NeutronPrefab is the neutron released by a destroyed atom
+var NeutronPrefab = ProjectilePrefab{
+    Speed:    constants.NeutronSpeed,
+    Radius:   constants.NeutronRadius,
+    Lifetime: constants.NeutronLifetime,
+    Damage:   constants.NeutronDamage,
+    Tint:     rl.SkyBlue,
+}
+
+// GetWeaponDef returns the definition of a weapon at an upgrade level (1 = not upgraded)
+func GetWeaponDef(weaponType WeaponType, level int) WeaponDef {
+    def, ok := WeaponDefs[weaponType]
+    if !ok {
+        def = WeaponDefs[WeaponBlaster]
+    }
+    
+    if level > 1 {
+        upgrades := level - 1
+        def.FireRate *= 1 + def.FireRatePerLevel*float32(upgrades)
+        def.ProjectileCount += def.CountPerLevel * upgrades
+    }
+    
+    return def
+}
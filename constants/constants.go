@@ -15,6 +15,9 @@ const (
     StatePause
     StateGameOver
     StateBossIntro     // Add this new state for boss intro
+    StateHighScores    // Local leaderboard, opened from the title menu
+    StateSaveSlots     // Saved campaigns, opened with Continue on the title menu
+    StateAchievements  // Achievements gallery, opened from the title menu
 )
 
 // Game parameters
@@ -22,6 +25,33 @@ const (
     MaxLevel             = 10
     PlayerInitialHealth  = 3
     FireCooldownDuration = 0.2 // seconds between shots
+    PlayerInitialSpeed   = 300
+    LevelTransitionTime  = 1.5 // seconds the "level complete" banner shows before the next level loads
+)
+
+// Dash and invulnerability parameters
+const (
+    DashDuration            = 0.2  // seconds a dash lasts
+    DashDistance            = 200  // pixels covered by a dash
+    DashCooldown            = 1.0  // seconds after a dash ends before the next one
+    HitInvulnerableTime     = 1.0  // seconds of invulnerability after being hit
+    BossHitInvulnerableTime = 1.5  // seconds of invulnerability after flying into the boss
+    InvulnFlickerRate       = 12.0 // times per second the player blinks while invulnerable
+)
+
+// Lives and respawn parameters
+const (
+    StartingLives           = 3
+    RespawnDelay            = 2.0  // seconds between the player's death and the respawn
+    RespawnInvulnerableTime = 3.0  // seconds of invulnerability after respawning
+    RespawnSafeRadius       = 250  // distance from any atom or boss a respawn spot should have
+    RespawnCandidates       = 12   // random spots tried when looking for a safe respawn
+    ContinuePenalty         = 0.25 // fraction of the checkpoint score lost when continuing
+    DeathExplosionBlasts    = 6
+    DeathExplosionInterval  = 0.15 // seconds between blasts in the death explosion
+    DeathExplosionSpread    = 40   // how far from the wreck blasts can go off
+    DeathBlastDuration      = 0.6  // seconds a single blast lasts
+    DeathBlastRadius        = 60
 )
 
 // Helicopter parameters
@@ -40,4 +70,129 @@ const (
 
     // How many seconds a bullet remains before expiring
     BulletLifetime = 2.0
+
+    // Enemy shots are slower than the player's so they can be dodged
+    EnemyBulletSpeed    = 280.0
+    EnemyBulletLifetime = 4.0
+    EnemyBulletRadius   = 6.0
+)
+
+// Camera parameters
+const (
+    // Size of the box around the screen center the player can move in
+    // before the camera starts to follow
+    CameraDeadZoneWidth  = 160.0
+    CameraDeadZoneHeight = 120.0
+
+    // How quickly the camera catches up with its target (higher is snappier)
+    CameraSmoothing = 6.0
+
+    // Zoom limits and the amount a single mouse wheel notch changes zoom by
+    CameraMinZoom  = 0.5
+    CameraMaxZoom  = 2.0
+    CameraZoomStep = 0.1
+)
+
+
+// Scientist parameters
+const (
+    ScientistWanderSpeed    = 30.0  // pixels per second while wandering
+    ScientistFollowSpeed    = 280.0 // maximum speed while following the player
+    ScientistFleeSpeed      = 120.0 // pixels per second while panicking
+    ScientistPickupRadius   = 60.0  // how close the player must be to collect a scientist
+    ScientistPanicRadius    = 110.0 // atoms closer than this make a scientist panic
+    ScientistPanicDuration  = 0.8   // seconds a scientist keeps running after a scare
+    ScientistSeparation     = 30.0  // followers try to keep at least this far apart
+    ScientistFollowDistance = 45.0  // followers stop this far behind the player
+)
+// Boss parameters
+const (
+    BossSpeed             = 200.0 // base movement speed while circling
+    BossDashSpeedFactor   = 3.0   // dash speed as a multiple of BossSpeed
+    BossPhaseDuration     = 6.0   // seconds spent circling or firing before switching
+    BossDashesPerPhase    = 3     // dashes in each dashing phase
+    BossTelegraphTime     = 0.7   // seconds the boss hovers and shows its aim before dashing
+    BossDashTime          = 0.5   // seconds a single dash lasts
+    BossFireInterval      = 0.8   // seconds between shots in the first stage
+    BossPhaseInvulnerable = 1.0   // invulnerability after switching phases
+    BossStageInvulnerable = 2.0   // invulnerability after crossing a health threshold
+)
+
+// BossStageThresholds are the health fractions below which the boss enters its next stage.
+// Each stage fires faster, dashes more often and adds projectiles to each volley.
+var BossStageThresholds = []float32{0.66, 0.33}
+
+// Fission parameters
+const (
+    FissionSpawnInvulnerable = 0.4 // seconds fragments can't be hurt after a split
+    FissionSpreadFactor      = 1.2 // fragment speed away from the split point, as a multiple of their normal speed
+)
+
+// Neutron parameters
+const (
+    NeutronsPerAtom      = 6     // neutrons emitted in a ring when an atom is destroyed
+    NeutronSpeed         = 320.0 // pixels per second
+    NeutronLifetime      = 0.35  // seconds before a neutron fades out
+    NeutronRadius        = 3.0
+    NeutronDamage        = 1     // neutrons only wear atoms down, unlike bullets
+    MaxNeutrons          = 48    // cap on live neutrons so big cascades stay cheap
+)
+
+// Fusion parameters
+const (
+    FusionSpawnInvulnerable = 0.3 // seconds a freshly fused atom can't be hurt or fuse again
+)
+
+// Electron shell parameters
+const (
+    ElectronRadius     = 4.0  // collider radius of a single electron
+    ElectronOrbitGap   = 10.0 // distance between the nucleus edge and the first shell
+    ElectronShellGap   = 6.0  // extra distance for each further electron
+    ElectronOrbitSpeed = 3.0  // radians per second
+    ElectronRegenDelay = 4.0  // seconds before a knocked off electron comes back
+)
+
+// Radioactive decay parameters
+const (
+    DecayWarningTime       = 1.5 // seconds of warning shown before an atom decays
+    DecaySpawnInvulnerable = 0.3 // seconds a freshly decayed atom can't be hurt
+)
+
+// Radiation dose parameters
+const (
+    MaxRadiationDose       = 100.0
+    AtomRadiationRange     = 150.0 // distance past an atom's edge at which its radiation fades out
+    RadiationDecayRate     = 1.5   // dose lost per second
+    RescueZoneDecayRate    = 12.0  // dose lost per second while in the rescue zone
+    RadiationDrainInterval = 4.0   // seconds per health point lost past the first threshold; shorter past each further one
+    HazardZoneDoseRate     = 10.0  // dose per second inside a hazard zone
+    HazardZoneMinRadius    = 80.0
+    HazardZoneMaxRadius    = 140.0
+    MaxHazardZones         = 4
+    GeigerBackgroundRate   = 0.3   // clicks per second with no radiation nearby
+    GeigerClicksPerDose    = 3.0   // extra clicks per second for each unit of dose rate
+    MaxGeigerRate          = 30.0  // clicks per second
+)
+
+// RadiationThresholds are the doses past which radiation sickness starts draining health, in rising order
+var RadiationThresholds = []float32{40, 70, 90}
+
+// Weapon parameters
+const (
+    MaxWeaponLevel     = 3   // weapon power-ups stop upgrading a weapon past this level
+    RecoilDamping      = 8.0 // how quickly recoil wears off, per second
+    WeaponPickupChance = 0.5 // chance of a new weapon pickup on levels after the first
+)
+
+// Power-up effect parameters
+const (
+    MagnetPullSpeed = 300.0 // pixels per second pickups move toward a magnetized player
+)
+
+// Loot parameters
+const (
+    LootDespawnTime = 12.0 // seconds a dropped pickup stays before it vanishes
+    LootBlinkTime   = 3.0  // seconds before vanishing that a dropped pickup starts blinking
+    LootBlinkRate   = 8.0  // blinks per second
+    LootScatter     = 25.0 // distance between pickups dropped together
 )
@@ -0,0 +1,46 @@
+// game/achievements.go
+package game
+
+import (
+    "atomblaster/systems"
+    "atomblaster/ui/models"
+
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AchievementToastTime is how long an unlock toast stays on screen, in seconds
+const AchievementToastTime = 4.0
+
+// unlockAchievement announces a newly earned achievement and saves it right away
+func (g *GameState) unlockAchievement(achievement systems.Achievement) {
+    g.Toasts.AddToast("ACHIEVEMENT UNLOCKED", achievement.Name, AchievementToastTime)
+    g.saveAchievements()
+}
+
+// saveAchievements writes achievement progress and refreshes the gallery
+func (g *GameState) saveAchievements() {
+    if err := g.Achievements.Save(); err != nil {
+        rl.TraceLog(rl.LogWarning, "achievements: %v", err)
+    }
+    g.refreshAchievements()
+}
+
+// refreshAchievements rebuilds the gallery from the current progress
+func (g *GameState) refreshAchievements() {
+    if g.AchievementsModel == nil {
+        return
+    }
+    
+    infos := make([]models.AchievementInfo, len(systems.Achievements))
+    for i, achievement := range systems.Achievements {
+        infos[i] = models.AchievementInfo{
+            Name:        achievement.Name,
+            Description: achievement.Description,
+            Progress:    g.AchievementSystem.Progress(achievement),
+            Goal:        achievement.Goal,
+            Unlocked:    g.Achievements.IsUnlocked(achievement.ID),
+            Date:        g.Achievements.Unlocked[achievement.ID],
+        }
+    }
+    g.AchievementsModel.Achievements = infos
+}
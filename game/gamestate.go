@@ -5,11 +5,15 @@ import (
     "atomblaster/audio"
     "atomblaster/components"
     "atomblaster/constants"
+    "atomblaster/storage"
     "atomblaster/systems"
     "atomblaster/ui"
     "atomblaster/ui/controllers"
     "atomblaster/ui/models"
     "atomblaster/ui/views"
+    "atomblaster/util"
+    "fmt"
+    "time"
     rl "github.com/gen2brain/raylib-go/raylib"
 )
 
@@ -19,6 +23,12 @@ type GameState struct {
     CurrentState   int
     Score          int
     Health         int
+    Lives          int
+    Checkpoint     Checkpoint // Start of the current level, where a continue picks up
+    RadiationDose  float32 // Player's radiation dose, mirrored for the HUD
+    WeaponName     string  // Player's current weapon and level, mirrored for the HUD
+    Effects        []models.EffectTimer // Player's running timed effects, mirrored for the HUD
+    Combo          float32              // Current combo multiplier, mirrored for the HUD
     Level          int
     ScientistsRescued int
     TotalScientists   int
@@ -27,11 +37,22 @@ type GameState struct {
     GameOver       bool
     BossDefeated   bool
     IsBossLevel    bool
+    WorldBounds    rl.Rectangle // Size of the current level, separate from the screen
+    Upgrades       PlayerUpgrades // Player improvements carried between levels
+    Difficulty     systems.DifficultyProfile // Chosen difficulty preset, with any director adjustments
+    Adaptive       bool                      // Whether the difficulty director is on; adaptive runs are unranked
+    RNG            *util.RNG      // Seeded randomness for gameplay systems, so a run can be replayed from its seed
+    HighScores     *storage.HighScoreTable // Local leaderboard
+    SaveSlot       int                     // Slot the campaign autosaves to (-1 = not saving)
+    Achievements   *storage.AchievementProgress // Achievement progress, kept across sessions
+    runStarted     time.Time                    // When the run began, naming its stats file
+    submittedAt    time.Time               // Date of this run's leaderboard entry, replaced if the run continues
     
     // ECS Framework
     ComponentRegistry *components.ComponentTypeRegistry
     EntityManager    *components.EntityManager
     SystemManager    *systems.SystemManager
+    EntityFactory    *components.EntityFactory
     
     // Systems
     MovementSystem   *systems.MovementSystem
@@ -39,6 +60,23 @@ type GameState struct {
     CollisionSystem  *systems.CollisionSystem
     InputSystem      *systems.InputSystem
     ParticleSystem   *systems.ParticleSystem
+    Camera           *systems.Camera
+    ScientistSystem  *systems.ScientistSystem
+    AtomAISystem     *systems.AtomAISystem
+    ElectronSystem   *systems.ElectronSystem
+    BossAISystem     *systems.BossAISystem
+    DecaySystem      *systems.DecaySystem
+    RadiationSystem  *systems.RadiationSystem
+    ProjectileSystem *systems.ProjectileSystem
+    EffectSystem     *systems.EffectSystem
+    LootSystem       *systems.LootSystem
+    RespawnSystem    *systems.RespawnSystem
+    ScoreSystem      *systems.ScoreSystem
+    AchievementSystem *systems.AchievementSystem
+    StatsSystem      *systems.StatsSystem
+    Director         *systems.DifficultyDirector
+    Events           *systems.EventBus
+    LevelSystem      *systems.LevelSystem
     
     // UI Screens
     IntroScreen     *ui.Screen
@@ -47,6 +85,16 @@ type GameState struct {
     PauseScreen     *ui.Screen
     GameOverScreen  *ui.Screen
     BossIntroScreen *ui.Screen
+    HighScoresScreen *ui.Screen
+    SaveSlotsScreen *ui.Screen
+    AchievementsScreen *ui.Screen
+    Toasts          *ui.ToastSystem
+    TitleModel      *models.TitleModel
+    GameOverModel   *models.GameOverModel
+    HighScoresModel *models.HighScoresModel
+    SaveSlotsModel  *models.SaveSlotsModel
+    AchievementsModel *models.AchievementsModel
+    BossIntroModel  *models.BossIntroModel
     
     // Audio
     Audio *audio.AudioSystem
@@ -55,8 +103,9 @@ type GameState struct {
     Background     rl.Texture2D
     PlayerSprite   rl.Texture2D
     EnemySprite    rl.Texture2D
-    BulletSprite   rl.Texture2D
-    PowerUpSprites [3]rl.Texture2D
+    BulletSprite      rl.Texture2D
+    EnemyBulletSprite rl.Texture2D
+    PowerUpSprites    [3]rl.Texture2D
 }
 
 // NewGameState creates a new game state
@@ -64,19 +113,39 @@ func NewGameState(audioSystem *audio.AudioSystem) *GameState {
     g := &GameState{
         CurrentState:      constants.StateIntro,
         Score:             0,
-        Health:            3,
+        Health:            constants.PlayerInitialHealth,
+        Lives:             constants.StartingLives,
         Level:             1,
         ScientistsRescued: 0,
         TotalScientists:   0,
         GameOver:          false,
         IsBossLevel:       false,
         BossDefeated:      false,
+        Upgrades:          defaultPlayerUpgrades(),
+        Difficulty:        systems.GetDifficulty(systems.DifficultyNormal),
+        RNG:               util.NewRNG(time.Now().UnixNano()),
+        SaveSlot:          -1,
+        runStarted:        time.Now(),
         Audio:             audioSystem,
     }
     
     // Initialize assets
     g.initializeAssets()
     
+    // Load the leaderboard; a missing or tampered file starts a fresh one
+    highScores, err := storage.LoadHighScores()
+    if err != nil {
+        rl.TraceLog(rl.LogWarning, "high scores: %v", err)
+    }
+    g.HighScores = highScores
+    
+    achievements, err := storage.LoadAchievements()
+    if err != nil {
+        rl.TraceLog(rl.LogWarning, "achievements: %v", err)
+    }
+    g.Achievements = achievements
+    g.Toasts = ui.NewToastSystem()
+    
     // Set start time
     g.StartTime = int64(rl.GetTime())
     
@@ -88,6 +157,8 @@ func NewGameState(audioSystem *audio.AudioSystem) *GameState {
     
     // Initialize screens
     g.createScreens()
+    g.refreshSaveSlots()
+    g.refreshAchievements()
     
     return g
 }
@@ -98,6 +169,7 @@ func (g *GameState) initializeAssets() {
     g.PlayerSprite = rl.LoadTexture("assets/helicopter.png")
     g.EnemySprite = rl.LoadTexture("assets/atom.png")
     g.BulletSprite = rl.LoadTexture("assets/bullet.png")
+    g.EnemyBulletSprite = rl.LoadTexture("assets/enemy_bullet.png")
     
     // Load power-up sprites
     g.PowerUpSprites[0] = rl.LoadTexture("assets/powerup_weapon.png")
@@ -122,123 +194,250 @@ func (g *GameState) initializeECS() {
     g.ComponentRegistry.Register("Player")
     g.ComponentRegistry.Register("Enemy")
     g.ComponentRegistry.Register("Scientist")
+    g.ComponentRegistry.Register("Door")
+    g.ComponentRegistry.Register("BossAI")
+    g.ComponentRegistry.Register("Faction")
+    g.ComponentRegistry.Register("Cascade")
+    g.ComponentRegistry.Register("Electron")
+    g.ComponentRegistry.Register("HazardZone")
+    g.ComponentRegistry.Register("Weapon")
+    g.ComponentRegistry.Register("Projectile")
+    g.ComponentRegistry.Register("ActiveEffects")
     
     // Create entity manager
     g.EntityManager = components.NewEntityManager(g.ComponentRegistry)
     
+    // Create entity factory for entities spawned by systems (e.g. enemy bullets)
+    g.EntityFactory = components.NewEntityFactory(
+        g.ComponentRegistry,
+        g.EntityManager,
+        g.PlayerSprite,
+        g.EnemySprite,
+        g.BulletSprite,
+        g.EnemyBulletSprite,
+        g.PowerUpSprites,
+    )
+    
+    // Atoms get the difficulty's health wherever they spawn, including fission and decay products
+    g.EntityFactory.SetHealthScaler(func(health int) int {
+        return g.Difficulty.ScaleHealth(health)
+    })
+    
     // Create system manager
     g.SystemManager = systems.NewSystemManager(g.EntityManager)
     
+    // Create the event bus systems use to notify each other and the game state
+    g.Events = systems.NewEventBus()
+    g.Events.Subscribe(systems.EventScientistRescued, func(e systems.Event) {
+        g.ScientistsRescued++
+    })
+    
     // Create systems
-    g.MovementSystem = systems.NewMovementSystem(g.EntityManager, g.ComponentRegistry)
-    g.RenderSystem = systems.NewRenderSystem(g.EntityManager, g.ComponentRegistry, g.Background)
-    g.CollisionSystem = systems.NewCollisionSystem(g.EntityManager, g.ComponentRegistry, &g.Score)
-    g.InputSystem = systems.NewInputSystem(g.EntityManager, g.ComponentRegistry, &g.CurrentState, g.Audio)
+    g.Camera = systems.NewCamera(g.EntityManager, g.ComponentRegistry, &g.WorldBounds)
+    g.MovementSystem = systems.NewMovementSystem(g.EntityManager, g.ComponentRegistry, &g.WorldBounds)
+    g.RenderSystem = systems.NewRenderSystem(g.EntityManager, g.ComponentRegistry, g.Background, &g.WorldBounds)
+    g.CollisionSystem = systems.NewCollisionSystem(g.EntityManager, g.ComponentRegistry, g.Events, g.EntityFactory, g.RNG, &g.Difficulty)
+    g.ScoreSystem = systems.NewScoreSystem(g.EntityManager, g.ComponentRegistry, &g.Score, g.Events)
+    g.AchievementSystem = systems.NewAchievementSystem(g.Achievements, g.Events, g.unlockAchievement)
+    g.StatsSystem = systems.NewStatsSystem(g.Events)
+    g.Director = systems.NewDifficultyDirector(g.Events)
+    g.InputSystem = systems.NewInputSystem(g.EntityManager, g.ComponentRegistry, &g.CurrentState, g.Camera, g.Events, &g.Difficulty, g.Audio)
     g.ParticleSystem = systems.NewParticleSystem(g.EntityManager, g.ComponentRegistry)
+    g.ScientistSystem = systems.NewScientistSystem(g.EntityManager, g.ComponentRegistry, &g.WorldBounds, g.RNG, g.Events)
+    g.AtomAISystem = systems.NewAtomAISystem(g.EntityManager, g.ComponentRegistry, g.RNG)
+    g.ElectronSystem = systems.NewElectronSystem(g.EntityManager, g.ComponentRegistry)
+    g.BossAISystem = systems.NewBossAISystem(g.EntityManager, g.ComponentRegistry, g.EntityFactory)
+    g.DecaySystem = systems.NewDecaySystem(g.EntityManager, g.ComponentRegistry, g.EntityFactory, g.RNG, g.Events)
+    g.ProjectileSystem = systems.NewProjectileSystem(g.EntityManager, g.ComponentRegistry, &g.WorldBounds)
+    g.EffectSystem = systems.NewEffectSystem(g.EntityManager, g.ComponentRegistry)
+    g.LootSystem = systems.NewLootSystem(g.EntityManager, g.ComponentRegistry, g.EntityFactory, g.RNG, &g.Level, &g.Difficulty, g.Events)
+    g.RadiationSystem = systems.NewRadiationSystem(g.EntityManager, g.ComponentRegistry, g.Events, &g.Difficulty, g.playGeigerClick)
+    g.RespawnSystem = systems.NewRespawnSystem(
+        g.EntityManager,
+        g.ComponentRegistry,
+        &g.WorldBounds,
+        &g.Lives,
+        g.RNG,
+        g.Events,
+        g.capturePlayerUpgrades,
+        g.respawnPlayer,
+        g.loseGame,
+    )
+    g.LevelSystem = systems.NewLevelSystem(g.EntityManager, g.ComponentRegistry, &g.Level, g.Events, g.loadLevel, g.completeGame)
     
     // Add systems to system manager in order of execution
     g.SystemManager.AddSystem(g.InputSystem)
+    g.SystemManager.AddSystem(g.ScientistSystem)
+    g.SystemManager.AddSystem(g.AtomAISystem)
+    g.SystemManager.AddSystem(g.BossAISystem)
+    g.SystemManager.AddSystem(g.DecaySystem)
+    g.SystemManager.AddSystem(g.EffectSystem)
+    g.SystemManager.AddSystem(g.LootSystem)
     g.SystemManager.AddSystem(g.MovementSystem)
+    g.SystemManager.AddSystem(g.ProjectileSystem)
+    g.SystemManager.AddSystem(g.ElectronSystem)
+    g.SystemManager.AddSystem(g.Camera)
     g.SystemManager.AddSystem(g.CollisionSystem)
+    g.SystemManager.AddSystem(g.RadiationSystem)
+    g.SystemManager.AddSystem(g.RespawnSystem)
+    g.SystemManager.AddSystem(g.ScoreSystem)
+    g.SystemManager.AddSystem(g.AchievementSystem)
+    g.SystemManager.AddSystem(g.StatsSystem)
+    g.SystemManager.AddSystem(g.Director)
     g.SystemManager.AddSystem(g.ParticleSystem)
+    g.SystemManager.AddSystem(g.LevelSystem)
     g.SystemManager.AddSystem(g.RenderSystem)
 }
 
 // initLevel resets the level state and spawns entities
 func (g *GameState) initLevel() {
+    config := getLevelConfig(g.Level)
+    
+    // Let the director react to the last attempt before the level is built
+    if entry := g.Director.StartLevel(g.Level, config.ParTime); entry != nil {
+        rl.TraceLog(rl.LogInfo, "difficulty director: %s", entry.String())
+    }
+    g.Difficulty = g.Director.Apply(systems.GetDifficulty(g.Difficulty.Level))
+    
+    // Save before anything draws from the RNG, so loading rebuilds the level the same way
+    g.autosave()
+    
+    // Size the world for this level before anything is placed in it
+    g.WorldBounds = rl.Rectangle{X: 0, Y: 0, Width: config.WorldWidth, Height: config.WorldHeight}
+    
     // Reset player position and properties
     g.createPlayer()
     
     // Reset state
     g.ScientistsRescued = 0
+    g.TotalScientists = 0
     g.GameOver = false
     g.BossDefeated = false
     
-    // Check if this is the boss level (level 5)
-    g.IsBossLevel = (g.Level == 5)
+    // Check if this is a boss level
+    g.IsBossLevel = config.BossLevel
     
     if g.IsBossLevel {
         // Create boss
         g.createBoss()
         g.CurrentState = constants.StateBossIntro
+        
+        // Replay the intro animation from the start for every boss level
+        if g.BossIntroModel != nil {
+            g.BossIntroModel.Reset()
+        }
     } else {
         // Create normal level entities
         g.createAtoms()
         g.createScientists()
         g.createRescueZone()
+        g.createHazardZones()
     }
     
     // Create door
     g.createDoor()
     
-    // Spawn powerups
+    // Spawn powerups, with fresh drop limits for the level
+    g.LootSystem.ResetLevel()
     g.createPowerUps()
+    
+    // Start the camera on the player rather than panning in from the last level
+    g.Camera.Snap()
+    
+    // A continue restarts the run from here
+    g.RespawnSystem.Reset()
+    g.LevelSystem.Reset()
+    g.ScoreSystem.StartLevel(config.ParTime)
+    g.AchievementSystem.StartLevel()
+    g.StatsSystem.StartLevel(g.Level)
+    g.Checkpoint = Checkpoint{
+        Level:     g.Level,
+        Score:     g.Score,
+        Health:    g.Health,
+        Upgrades:  g.Upgrades.copy(),
+        Breakdown: g.ScoreSystem.Breakdown,
+    }
 }
 
-// createPlayer creates the player entity with all required components
+// createPlayer creates the player entity at the start of the level
 func (g *GameState) createPlayer() {
+    g.createPlayerAt(rl.Vector2{X: g.WorldBounds.Width / 4, Y: g.WorldBounds.Height / 2})
+}
+
+// createPlayerAt creates the player entity with all required components at the given position
+func (g *GameState) createPlayerAt(pos rl.Vector2) components.EntityID {
     // Create player entity
     playerID := g.EntityManager.CreateEntity()
     
     // Add components
-    g.EntityManager.AddComponent(playerID, components.NewPosition(float32(constants.ScreenWidth/4), float32(constants.ScreenHeight/2), g.ComponentRegistry))
+    g.EntityManager.AddComponent(playerID, components.NewPosition(pos.X, pos.Y, g.ComponentRegistry))
     g.EntityManager.AddComponent(playerID, components.NewVelocity(0, 0, g.ComponentRegistry))
     g.EntityManager.AddComponent(playerID, components.NewCircleCollider(constants.HelicopterWidth/2, g.ComponentRegistry))
     g.EntityManager.AddComponent(playerID, components.NewSprite(g.PlayerSprite, g.ComponentRegistry))
     g.EntityManager.AddComponent(playerID, components.NewHealth(g.Health, 10, g.ComponentRegistry))
     g.EntityManager.AddComponent(playerID, components.NewTag(components.PlayerTag, g.ComponentRegistry))
-    g.EntityManager.AddComponent(playerID, components.NewPlayer(300, g.ComponentRegistry))
+    
+    // Apply upgrades carried over from previous levels
+    g.EntityManager.AddComponent(playerID, components.NewPlayer(g.Upgrades.Speed, g.ComponentRegistry))
+    
+    weapon := components.NewWeapon(g.ComponentRegistry)
+    for weaponType, level := range g.Upgrades.Weapons {
+        weapon.Levels[weaponType] = level
+    }
+    weapon.Current = g.Upgrades.CurrentWeapon
+    g.EntityManager.AddComponent(playerID, weapon)
+    
+    // Timed effects don't carry over between levels
+    g.EntityManager.AddComponent(playerID, components.NewActiveEffects(g.ComponentRegistry))
+    
+    return playerID
+}
+
+// respawnPlayer brings the player back after losing a life, with full health and
+// a moment of invulnerability. Upgrades were captured when the player died.
+func (g *GameState) respawnPlayer(pos rl.Vector2) {
+    g.Health = g.Difficulty.PlayerHealth
+    playerID := g.createPlayerAt(pos)
+    
+    if playerComp, has := g.EntityManager.GetComponent(playerID, g.ComponentRegistry.GetIDByName("Player")); has {
+        playerComp.(*components.Player).MakeInvulnerable(constants.RespawnInvulnerableTime)
+    }
 }
 
 // createAtoms creates enemy atom entities
 func (g *GameState) createAtoms() {
-    // Number of atoms based on level
+    // Number of atoms based on level and difficulty
     numAtoms := g.Level*2 + 3
     if g.IsBossLevel {
         numAtoms = 5 // Fewer atoms in boss level
     }
+    numAtoms = g.Difficulty.ScaleCount(numAtoms)
     
     for i := 0; i < numAtoms; i++ {
         // Random position
         pos := rl.Vector2{
-            X: float32(rl.GetRandomValue(constants.ScreenWidth/4, 3*constants.ScreenWidth/4)),
-            Y: float32(rl.GetRandomValue(20, constants.ScreenHeight-40)),
-        }
-        
-        // Create random velocity vector based on speed and level
-        speed := float32(100+g.Level*10) + float32(rl.GetRandomValue(-20, 20))
-        vel := rl.Vector2{
-            X: float32(rl.GetRandomValue(-100, 100)) / 100.0 * speed,
-            Y: float32(rl.GetRandomValue(-100, 100)) / 100.0 * speed,
+            X: g.RNG.Range(g.WorldBounds.Width/4, 3*g.WorldBounds.Width/4),
+            Y: g.RNG.Range(20, g.WorldBounds.Height-40),
         }
         
         // Determine atom type
         atomType := components.NormalAtom
-        if g.IsBossLevel && rl.GetRandomValue(0, 1) == 1 {
+        if g.IsBossLevel && g.RNG.Chance(0.5) {
             atomType = components.FastAtom
+        } else if !g.IsBossLevel && g.Level >= 3 && g.RNG.Intn(5) == 0 {
+            atomType = components.BigAtom // Big atoms split into smaller ones when destroyed
         }
+        prefab := components.GetAtomPrefab(atomType)
         
-        // Create atom entity
-        atomID := g.EntityManager.CreateEntity()
-        
-        // Add components
-        g.EntityManager.AddComponent(atomID, components.NewPosition(pos.X, pos.Y, g.ComponentRegistry))
-        g.EntityManager.AddComponent(atomID, components.NewVelocity(vel.X, vel.Y, g.ComponentRegistry))
-        g.EntityManager.AddComponent(atomID, components.NewSprite(g.EnemySprite, g.ComponentRegistry))
-        g.EntityManager.AddComponent(atomID, components.NewTag(components.EnemyTag, g.ComponentRegistry))
-        g.EntityManager.AddComponent(atomID, components.NewEnemy(atomType, speed, g.ComponentRegistry))
-        
-        // Add health and collider based on type
-        if atomType == components.NormalAtom {
-            g.EntityManager.AddComponent(atomID, components.NewCircleCollider(15, g.ComponentRegistry))
-            g.EntityManager.AddComponent(atomID, components.NewHealth(2, 2, g.ComponentRegistry))
-        } else if atomType == components.FastAtom {
-            g.EntityManager.AddComponent(atomID, components.NewCircleCollider(12, g.ComponentRegistry))
-            g.EntityManager.AddComponent(atomID, components.NewHealth(1, 1, g.ComponentRegistry))
-        } else if atomType == components.BigAtom {
-            g.EntityManager.AddComponent(atomID, components.NewCircleCollider(25, g.ComponentRegistry))
-            g.EntityManager.AddComponent(atomID, components.NewHealth(4, 4, g.ComponentRegistry))
+        // Create random velocity vector based on speed, level and difficulty
+        speed := (float32(100+g.Level*10) + g.RNG.Range(-20, 20)) * prefab.SpeedFactor * g.Difficulty.EnemySpeed
+        vel := rl.Vector2{
+            X: g.RNG.Range(-1, 1) * speed,
+            Y: g.RNG.Range(-1, 1) * speed,
         }
+        
+        // The factory scales the atom's health for the difficulty
+        g.EntityFactory.CreateAtomWithSpeed(pos.X, pos.Y, vel.X, vel.Y, atomType, speed)
     }
 }
 
@@ -248,13 +447,18 @@ func (g *GameState) createBoss() {
     bossID := g.EntityManager.CreateEntity()
     
     // Add components
-    g.EntityManager.AddComponent(bossID, components.NewPosition(float32(constants.ScreenWidth-200), 150, g.ComponentRegistry))
+    g.EntityManager.AddComponent(bossID, components.NewPosition(g.WorldBounds.Width-200, 150, g.ComponentRegistry))
     g.EntityManager.AddComponent(bossID, components.NewVelocity(0, 0, g.ComponentRegistry))
     g.EntityManager.AddComponent(bossID, components.NewRectangleCollider(80, 40, g.ComponentRegistry))
     g.EntityManager.AddComponent(bossID, components.NewSprite(g.PlayerSprite, g.ComponentRegistry)) // Using player sprite for simplicity
-    g.EntityManager.AddComponent(bossID, components.NewHealth(100, 100, g.ComponentRegistry))
+    bossHealth := g.Difficulty.ScaleHealth(100)
+    g.EntityManager.AddComponent(bossID, components.NewHealth(bossHealth, bossHealth, g.ComponentRegistry))
     g.EntityManager.AddComponent(bossID, components.NewTag(components.BossTag, g.ComponentRegistry))
-    g.EntityManager.AddComponent(bossID, components.NewEnemy(components.Boss, 200, g.ComponentRegistry))
+    g.EntityManager.AddComponent(bossID, components.NewEnemy(components.Boss, 200*g.Difficulty.EnemySpeed, g.ComponentRegistry))
+    
+    // Circle around the middle of the arena
+    arenaCenter := rl.Vector2{X: g.WorldBounds.X + g.WorldBounds.Width/2, Y: g.WorldBounds.Y + g.WorldBounds.Height/2}
+    g.EntityManager.AddComponent(bossID, components.NewBossAI(arenaCenter, g.ComponentRegistry))
 }
 
 // createScientists creates scientist entities to rescue
@@ -274,8 +478,8 @@ func (g *GameState) createScientists() {
     
     for i := 0; i < scientistCount; i++ {
         // Place scientists around the level
-        x := constants.ScreenWidth/4 + float32(rl.GetRandomValue(0, int32(constants.ScreenWidth/2)))
-        y := constants.ScreenHeight/6 + float32(rl.GetRandomValue(0, int32(constants.ScreenHeight*2/3)))
+        x := g.WorldBounds.Width/4 + g.RNG.Range(0, g.WorldBounds.Width/2)
+        y := g.WorldBounds.Height/6 + g.RNG.Range(0, g.WorldBounds.Height*2/3)
         
         // Create scientist entity
         scientistID := g.EntityManager.CreateEntity()
@@ -297,8 +501,8 @@ func (g *GameState) createRescueZone() {
     }
     
     // Create rescue zone on the left side
-    rescueX := float32(rl.GetRandomValue(50, 200))
-    rescueY := constants.ScreenHeight - float32(rl.GetRandomValue(100, 200))
+    rescueX := g.RNG.Range(50, 200)
+    rescueY := g.WorldBounds.Height - g.RNG.Range(100, 200)
     
     // Create rescue zone entity
     rescueZoneID := g.EntityManager.CreateEntity()
@@ -309,6 +513,27 @@ func (g *GameState) createRescueZone() {
     g.EntityManager.AddComponent(rescueZoneID, components.NewTag(components.RescueZoneTag, g.ComponentRegistry))
 }
 
+// createHazardZones scatters contaminated areas over the level, more of them on later levels.
+// Zones are kept out of the left quarter so the player never starts inside one.
+func (g *GameState) createHazardZones() {
+    count := g.Level - 1
+    if count > constants.MaxHazardZones {
+        count = constants.MaxHazardZones
+    }
+    
+    for i := 0; i < count; i++ {
+        radius := g.RNG.Range(constants.HazardZoneMinRadius, constants.HazardZoneMaxRadius)
+        x := g.RNG.Range(g.WorldBounds.Width/4+radius, g.WorldBounds.Width-radius-60)
+        y := g.RNG.Range(radius, g.WorldBounds.Height-radius)
+        
+        zoneID := g.EntityManager.CreateEntity()
+        
+        g.EntityManager.AddComponent(zoneID, components.NewPosition(x, y, g.ComponentRegistry))
+        g.EntityManager.AddComponent(zoneID, components.NewTag(components.HazardZoneTag, g.ComponentRegistry))
+        g.EntityManager.AddComponent(zoneID, components.NewHazardZone(radius, constants.HazardZoneDoseRate, g.ComponentRegistry))
+    }
+}
+
 // createDoor creates the door entity (level exit)
 func (g *GameState) createDoor() {
     // Create door on the right side
@@ -316,81 +541,54 @@ func (g *GameState) createDoor() {
     
     // Add components
     g.EntityManager.AddComponent(doorID, components.NewPosition(
-        constants.ScreenWidth - 35,
-        constants.ScreenHeight/2,
+        g.WorldBounds.Width - 35,
+        g.WorldBounds.Height/2,
         g.ComponentRegistry,
     ))
     g.EntityManager.AddComponent(doorID, components.NewRectangleCollider(30, 100, g.ComponentRegistry))
     g.EntityManager.AddComponent(doorID, components.NewTag(components.DoorTag, g.ComponentRegistry))
+    g.EntityManager.AddComponent(doorID, components.NewDoor(g.ComponentRegistry))
 }
 
-// createPowerUps creates power-up entities
+// createPowerUps creates the power-ups lying around when a level starts
 func (g *GameState) createPowerUps() {
-    // Create gun power-up if player doesn't have a gun
+    // Create a blaster power-up if the player has no weapon yet; later levels
+    // may offer another weapon, or an upgrade of one already owned
     playerEntities := g.EntityManager.GetEntitiesWithComponents(
         g.ComponentRegistry.GetIDByName("Player"),
-        g.ComponentRegistry.GetIDByName("Tag"),
+        g.ComponentRegistry.GetIDByName("Weapon"),
     )
     
     if len(playerEntities) > 0 {
-        playerEntityID := playerEntities[0]
-        playerComp, _ := g.EntityManager.GetComponent(
-            playerEntityID,
-            g.ComponentRegistry.GetIDByName("Player"),
+        weaponComp, _ := g.EntityManager.GetComponent(
+            playerEntities[0],
+            g.ComponentRegistry.GetIDByName("Weapon"),
         )
-        player := playerComp.(*components.Player)
+        weapon := weaponComp.(*components.Weapon)
         
-        if !player.HasGun {
-            // Create gun power-up
-            gunX := float32(rl.GetRandomValue(100, int32(constants.ScreenWidth-100)))
-            gunY := float32(rl.GetRandomValue(100, int32(constants.ScreenHeight-100)))
-            
-            gunID := g.EntityManager.CreateEntity()
-            
-            g.EntityManager.AddComponent(gunID, components.NewPosition(gunX, gunY, g.ComponentRegistry))
-            g.EntityManager.AddComponent(gunID, components.NewCircleCollider(15, g.ComponentRegistry))
-            g.EntityManager.AddComponent(gunID, components.NewSprite(g.PowerUpSprites[0], g.ComponentRegistry))
-            g.EntityManager.AddComponent(gunID, components.NewTag(components.PowerUpTag, g.ComponentRegistry))
-            g.EntityManager.AddComponent(gunID, components.NewPowerUp(components.PowerUpGun, 0, 0, g.ComponentRegistry))
+        if !weapon.HasAny() || (g.Level > 1 && g.RNG.Chance(constants.WeaponPickupChance)) {
+            g.LootSystem.Spawn(components.PowerUpWeapon, g.randomPickupPosition(), 0)
         }
     }
     
-    // Health power-up (more common in higher levels and boss level)
-    healthChance := int32(50)
-    if g.Level > 1 || g.IsBossLevel {
-        healthChance = 100 // Always give health in boss level
-    }
-    
-    if rl.GetRandomValue(0, 100) < healthChance {
-        healthX := float32(rl.GetRandomValue(100, int32(constants.ScreenWidth-100)))
-        healthY := float32(rl.GetRandomValue(100, int32(constants.ScreenHeight-100)))
-        
-        healthID := g.EntityManager.CreateEntity()
-        
-        g.EntityManager.AddComponent(healthID, components.NewPosition(healthX, healthY, g.ComponentRegistry))
-        g.EntityManager.AddComponent(healthID, components.NewCircleCollider(15, g.ComponentRegistry))
-        g.EntityManager.AddComponent(healthID, components.NewSprite(g.PowerUpSprites[1], g.ComponentRegistry))
-        g.EntityManager.AddComponent(healthID, components.NewTag(components.PowerUpTag, g.ComponentRegistry))
-        g.EntityManager.AddComponent(healthID, components.NewPowerUp(components.PowerUpHealth, 0, 0, g.ComponentRegistry))
-    }
-    
-    // Add speed boost pickup (more common in boss level)
-    speedChance := int32(20)
+    // Roll the level's starting loot; these pickups stay until collected
+    tables := components.LevelStartLoot
     if g.IsBossLevel {
-        speedChance = 50
+        tables = components.BossLevelStartLoot
     }
     
-    if rl.GetRandomValue(0, 100) < speedChance {
-        speedX := float32(rl.GetRandomValue(50, int32(constants.ScreenWidth-100)))
-        speedY := float32(rl.GetRandomValue(50, int32(constants.ScreenHeight-100)))
-        
-        speedID := g.EntityManager.CreateEntity()
-        
-        g.EntityManager.AddComponent(speedID, components.NewPosition(speedX, speedY, g.ComponentRegistry))
-        g.EntityManager.AddComponent(speedID, components.NewCircleCollider(15, g.ComponentRegistry))
-        g.EntityManager.AddComponent(speedID, components.NewSprite(g.PowerUpSprites[2], g.ComponentRegistry))
-        g.EntityManager.AddComponent(speedID, components.NewTag(components.PowerUpTag, g.ComponentRegistry))
-        g.EntityManager.AddComponent(speedID, components.NewPowerUp(components.PowerUpSpeed, 0, 50, g.ComponentRegistry))
+    if table := components.GetLootTable(tables, g.Level); table != nil {
+        for _, powerUp := range g.LootSystem.Roll(table, nil) {
+            g.LootSystem.Spawn(powerUp, g.randomPickupPosition(), 0)
+        }
+    }
+}
+
+// randomPickupPosition returns a random spot for a pickup, away from the edges of the world
+func (g *GameState) randomPickupPosition() rl.Vector2 {
+    return rl.Vector2{
+        X: g.RNG.Range(100, g.WorldBounds.Width-100),
+        Y: g.RNG.Range(100, g.WorldBounds.Height-100),
     }
 }
 
@@ -404,8 +602,11 @@ func (g *GameState) createScreens() {
     
     // Create title screen
     titleModel := models.NewTitleModel(g.Background)
+    titleModel.DifficultyName = g.Difficulty.Name
+    titleModel.Adaptive = g.Adaptive
+    g.TitleModel = titleModel
     titleView := views.NewTitleView(titleModel)
-    titleController := controllers.NewTitleController(titleModel, &g.CurrentState)
+    titleController := controllers.NewTitleController(titleModel, &g.CurrentState, g.NewCampaign, g.CycleDifficulty, g.ToggleAdaptive)
     g.TitleScreen = ui.NewScreen(titleModel, titleView, titleController)
     
     // Create game screen
@@ -422,6 +623,11 @@ func (g *GameState) createScreens() {
         &g.TotalScientists,
         &g.StartTime,
         &g.ElapsedTime,
+        &g.RadiationDose,
+        &g.WeaponName,
+        &g.Effects,
+        &g.Lives,
+        &g.Combo,
     )
     gameView := views.NewGameView(gameModel)
     gameController := controllers.NewGameController(gameModel)
@@ -435,37 +641,260 @@ func (g *GameState) createScreens() {
     
     // Create game over screen
     gameOverModel := models.NewGameOverModel(gameModel, false)
+    g.GameOverModel = gameOverModel
+    gameOverModel.MaxNameLength = storage.MaxNameLength
     gameOverView := views.NewGameOverView(gameOverModel, gameView)
-    gameOverController := controllers.NewGameOverController(gameOverModel, &g.CurrentState, g.ResetGame)
+    gameOverController := controllers.NewGameOverController(gameOverModel, &g.CurrentState, g.ResetGame, g.ContinueGame, g.submitHighScore)
     g.GameOverScreen = ui.NewScreen(gameOverModel, gameOverView, gameOverController)
     
     // Create boss intro screen
     bossIntroModel := models.NewBossIntroModel(g.Background, g.PlayerSprite, g.PlayerSprite)
+    g.BossIntroModel = bossIntroModel
     bossIntroView := views.NewBossIntroView(bossIntroModel)
     bossIntroController := controllers.NewBossIntroController(bossIntroModel, &g.CurrentState)
     g.BossIntroScreen = ui.NewScreen(bossIntroModel, bossIntroView, bossIntroController)
+    
+    // Create high scores screen
+    highScoresModel := models.NewHighScoresModel(g.Background, g.HighScores)
+    g.HighScoresModel = highScoresModel
+    highScoresView := views.NewHighScoresView(highScoresModel)
+    highScoresController := controllers.NewHighScoresController(highScoresModel, &g.CurrentState)
+    g.HighScoresScreen = ui.NewScreen(highScoresModel, highScoresView, highScoresController)
+    
+    // Create save slot screen
+    saveSlotsModel := models.NewSaveSlotsModel(g.Background)
+    g.SaveSlotsModel = saveSlotsModel
+    saveSlotsView := views.NewSaveSlotsView(saveSlotsModel)
+    saveSlotsController := controllers.NewSaveSlotsController(saveSlotsModel, &g.CurrentState, g.LoadGame)
+    g.SaveSlotsScreen = ui.NewScreen(saveSlotsModel, saveSlotsView, saveSlotsController)
+    
+    // Create achievements gallery
+    achievementsModel := models.NewAchievementsModel(g.Background)
+    g.AchievementsModel = achievementsModel
+    achievementsView := views.NewAchievementsView(achievementsModel)
+    achievementsController := controllers.NewAchievementsController(achievementsModel, &g.CurrentState)
+    g.AchievementsScreen = ui.NewScreen(achievementsModel, achievementsView, achievementsController)
 }
 
 // ResetGame resets the game state to start a new game
 func (g *GameState) ResetGame() {
+    g.resetRun()
+    
+    // Clear out the previous run and initialize the first level
+    g.EntityManager.DestroyAllEntities()
+    g.initLevel()
+}
+
+// resetRun puts the run back to the start of a new game without building a level
+func (g *GameState) resetRun() {
     // Reset game state
     g.Score = 0
-    g.Health = 3
+    g.Health = g.Difficulty.PlayerHealth
+    g.Lives = constants.StartingLives
+    g.RadiationDose = 0
+    g.Effects = nil
     g.Level = 1
     g.ScientistsRescued = 0
     g.TotalScientists = 0
     g.GameOver = false
     g.IsBossLevel = false
     g.BossDefeated = false
+    g.Upgrades = defaultPlayerUpgrades()
+    g.RNG.Reseed(time.Now().UnixNano())
+    g.LootSystem.Reset()
+    g.ScoreSystem.Reset()
+    g.StatsSystem.Reset()
+    g.Director.Reset()
+    g.Director.Enabled = g.Adaptive
+    g.runStarted = time.Now()
+    g.submittedAt = time.Time{}
+    g.GameOverModel.PlayerWon = false
+    g.Events.Clear()
     
     // Reset start time
     g.StartTime = int64(rl.GetTime())
     g.ElapsedTime = 0
+}
+
+// loadLevel tears down the current level and builds the level in g.Level,
+// carrying the player's upgrades over to the new level
+func (g *GameState) loadLevel() {
+    g.capturePlayerUpgrades()
+    g.saveAchievements()
+    g.EntityManager.DestroyAllEntities()
     
-    // Initialize first level
+    // Events queued earlier this frame belong to the old level
+    g.Events.Clear()
     g.initLevel()
 }
 
+// completeGame ends the run after the final level has been cleared
+func (g *GameState) completeGame() {
+    g.GameOver = true
+    g.GameOverModel.PlayerWon = true
+    g.GameOverModel.CanContinue = false
+    g.GameOverModel.Breakdown = g.scoreLines()
+    g.GameOverModel.Refresh()
+    g.finishRunStats(g.GameOverModel.PlayerWon)
+    g.offerHighScore()
+    g.saveAchievements()
+    g.CurrentState = constants.StateGameOver
+}
+
+// loseGame ends the run once the player is out of lives, offering a continue from the checkpoint
+func (g *GameState) loseGame() {
+    g.GameOver = true
+    g.GameOverModel.PlayerWon = false
+    g.GameOverModel.CanContinue = true
+    g.GameOverModel.ContinuePenalty = g.continuePenalty()
+    g.GameOverModel.Breakdown = g.scoreLines()
+    g.GameOverModel.Refresh()
+    g.finishRunStats(g.GameOverModel.PlayerWon)
+    g.offerHighScore()
+    g.saveAchievements()
+    g.CurrentState = constants.StateGameOver
+}
+
+// offerHighScore starts the name entry on the game over screen if the score makes the leaderboard
+func (g *GameState) offerHighScore() {
+    g.GameOverModel.Rank = 0
+    g.GameOverModel.PlayerName = ""
+    g.GameOverModel.Unranked = g.Adaptive
+    g.GameOverModel.EnteringName = !g.Adaptive && g.HighScores.Qualifies(g.Score)
+    
+    // Drop keys typed during play so they don't end up in the name
+    for rl.GetCharPressed() > 0 {
+    }
+}
+
+// submitHighScore records the run on the leaderboard under the given name and returns its rank
+func (g *GameState) submitHighScore(name string) int {
+    // A continued run replaces the entry it made before
+    if !g.submittedAt.IsZero() {
+        g.HighScores.Remove(g.submittedAt)
+    }
+    
+    rescueRate := 0
+    if g.TotalScientists > 0 {
+        rescueRate = g.ScientistsRescued * 100 / g.TotalScientists
+    }
+    
+    g.submittedAt = time.Now()
+    rank := g.HighScores.Insert(storage.HighScoreEntry{
+        Name:        name,
+        Score:       g.Score,
+        Level:       g.Level,
+        TimeSeconds: g.ElapsedTime,
+        RescueRate:  rescueRate,
+        Difficulty:  g.Difficulty.Name,
+        Date:        g.submittedAt,
+    })
+    if err := g.HighScores.Save(); err != nil {
+        rl.TraceLog(rl.LogWarning, "high scores: %v", err)
+    }
+    
+    g.HighScoresModel.Highlight = rank
+    return rank
+}
+
+// scoreLines lists the non-zero parts of the run's score for the results screen
+func (g *GameState) scoreLines() []models.ScoreLine {
+    b := g.ScoreSystem.Breakdown
+    parts := []models.ScoreLine{
+        {Label: "Kills", Points: b.Kills},
+        {Label: "Combos", Points: b.Combo},
+        {Label: "Double Score", Points: b.DoubleScore},
+        {Label: "Power-Ups", Points: b.PowerUps},
+        {Label: "Rescues", Points: b.Rescues},
+        {Label: "Rescue Bonus", Points: b.RescueBonus},
+        {Label: "No Damage", Points: b.NoDamage},
+        {Label: "Time Bonus", Points: b.Time},
+        {Label: "Continues", Points: b.Penalty},
+    }
+    
+    lines := make([]models.ScoreLine, 0, len(parts))
+    for _, line := range parts {
+        if line.Points != 0 {
+            lines = append(lines, line)
+        }
+    }
+    return lines
+}
+
+// continuePenalty returns the points lost by continuing from the checkpoint
+func (g *GameState) continuePenalty() int {
+    return int(float32(g.Checkpoint.Score) * constants.ContinuePenalty)
+}
+
+// ContinueGame restarts the current level from its checkpoint with a fresh set of
+// lives, at the cost of part of the score banked at the checkpoint
+func (g *GameState) ContinueGame() {
+    checkpoint := g.Checkpoint
+    penalty := g.continuePenalty()
+    
+    g.Score = checkpoint.Score - penalty
+    g.ScoreSystem.Restore(checkpoint.Breakdown, penalty)
+    g.Level = checkpoint.Level
+    g.Health = checkpoint.Health
+    if g.Health <= 0 {
+        g.Health = g.Difficulty.PlayerHealth
+    }
+    g.Lives = constants.StartingLives
+    g.RadiationDose = 0
+    g.Effects = nil
+    g.Upgrades = checkpoint.Upgrades.copy()
+    g.GameOverModel.PlayerWon = false
+    g.Events.Clear()
+    
+    g.EntityManager.DestroyAllEntities()
+    g.initLevel()
+}
+
+// CycleDifficulty steps through the difficulty presets for the next new campaign and
+// returns the name of the one now selected
+func (g *GameState) CycleDifficulty(step int) string {
+    count := len(systems.DifficultyProfiles)
+    next := (int(g.Difficulty.Level) + step + count) % count
+    g.Difficulty = systems.GetDifficulty(systems.Difficulty(next))
+    return g.Difficulty.Name
+}
+
+// ToggleAdaptive turns the difficulty director on or off for the next new campaign and
+// returns whether it is now on. Adaptive runs don't go on the leaderboard.
+func (g *GameState) ToggleAdaptive() bool {
+    g.Adaptive = !g.Adaptive
+    return g.Adaptive
+}
+
+// playGeigerClick plays a single Geiger counter click for the radiation system
+func (g *GameState) playGeigerClick() {
+    if g.Audio != nil {
+        g.Audio.PlaySound(audio.GeigerClickSound)
+    }
+}
+
+// capturePlayerUpgrades stores the player's current upgrades so they survive a level change
+func (g *GameState) capturePlayerUpgrades() {
+    playerEntities := g.EntityManager.GetEntitiesWithComponent(g.ComponentRegistry.GetIDByName("Player"))
+    if len(playerEntities) == 0 {
+        return
+    }
+    
+    playerComp, _ := g.EntityManager.GetComponent(playerEntities[0], g.ComponentRegistry.GetIDByName("Player"))
+    player := playerComp.(*components.Player)
+    
+    g.Upgrades.Speed = player.Speed
+    
+    if weaponComp, has := g.EntityManager.GetComponent(playerEntities[0], g.ComponentRegistry.GetIDByName("Weapon")); has {
+        weapon := weaponComp.(*components.Weapon)
+        g.Upgrades.Weapons = make(map[components.WeaponType]int)
+        for weaponType, level := range weapon.Levels {
+            g.Upgrades.Weapons[weaponType] = level
+        }
+        g.Upgrades.CurrentWeapon = weapon.Current
+    }
+}
+
 // Draw renders the current game state
 func (g *GameState) Draw() {
     rl.BeginDrawing()
@@ -482,10 +911,16 @@ func (g *GameState) Draw() {
         g.BossIntroScreen.Draw()
         
     case constants.StateGame:
-        // The render system handles drawing the game world
+        // The render system handles drawing the game world through the camera
+        g.Camera.Begin()
         g.RenderSystem.Draw()
+        g.RespawnSystem.Draw()
+        g.Camera.End()
+        
+        // Draw the level complete banner over the world
+        g.LevelSystem.Draw()
         
-        // Draw UI overlay
+        // Draw UI overlay in screen space
         g.GameScreen.Draw()
         
     case constants.StatePause:
@@ -493,8 +928,20 @@ func (g *GameState) Draw() {
         
     case constants.StateGameOver:
         g.GameOverScreen.Draw()
+        
+    case constants.StateHighScores:
+        g.HighScoresScreen.Draw()
+        
+    case constants.StateSaveSlots:
+        g.SaveSlotsScreen.Draw()
+        
+    case constants.StateAchievements:
+        g.AchievementsScreen.Draw()
     }
     
+    // Toasts show over every screen
+    g.Toasts.Draw()
+    
     rl.EndDrawing()
 }
 
@@ -503,6 +950,8 @@ func (g *GameState) Update(dt float32) {
     // Update elapsed time
     g.ElapsedTime = int64(rl.GetTime()) - g.StartTime
     
+    g.Toasts.Update()
+    
     // Update based on current state
     switch g.CurrentState {
     case constants.StateIntro:
@@ -512,7 +961,7 @@ func (g *GameState) Update(dt float32) {
         
     case constants.StateTitle:
         if g.TitleScreen.Update() {
-            g.CurrentState = constants.StateGame
+            // Controller handles the menu choice
         }
         
     case constants.StateBossIntro:
@@ -521,14 +970,9 @@ func (g *GameState) Update(dt float32) {
         }
         
     case constants.StateGame:
-        // Update game systems
+        // Update game systems; running out of lives is handled by the RespawnSystem
         g.updateGame(dt)
         
-        // Check for game over conditions
-        if g.Health <= 0 {
-            g.CurrentState = constants.StateGameOver
-        }
-        
     case constants.StatePause:
         if g.PauseScreen.Update() {
             // Controller handles state changes
@@ -538,6 +982,21 @@ func (g *GameState) Update(dt float32) {
         if g.GameOverScreen.Update() {
             // Controller handles restart/quit
         }
+        
+    case constants.StateHighScores:
+        if g.HighScoresScreen.Update() {
+            // Controller returns to the title menu
+        }
+        
+    case constants.StateSaveSlots:
+        if g.SaveSlotsScreen.Update() {
+            // Controller loads the chosen save or returns to the title menu
+        }
+        
+    case constants.StateAchievements:
+        if g.AchievementsScreen.Update() {
+            // Controller returns to the title menu
+        }
     }
 }
 
@@ -546,12 +1005,18 @@ func (g *GameState) updateGame(dt float32) {
     // Update all ECS systems
     g.SystemManager.UpdateAll(dt)
     
+    // Deliver the events systems raised this frame
+    g.Events.Dispatch()
+    
     // Update game state based on entity state
     g.updateGameState()
 }
 
 // updateGameState updates the game state based on entity state
 func (g *GameState) updateGameState() {
+    // Mirror the combo multiplier for the HUD
+    g.Combo = g.ScoreSystem.ComboMultiplier()
+    
     // Update player health
     playerEntities := g.EntityManager.GetEntitiesWithComponents(
         g.ComponentRegistry.GetIDByName("Player"),
@@ -567,11 +1032,48 @@ func (g *GameState) updateGameState() {
         health := healthComp.(*components.Health)
         
         g.Health = health.Current
+        
+        playerComp, _ := g.EntityManager.GetComponent(
+            playerEntityID,
+            g.ComponentRegistry.GetIDByName("Player"),
+        )
+        g.RadiationDose = playerComp.(*components.Player).RadiationDose
+        
+        g.WeaponName = ""
+        if weaponComp, has := g.EntityManager.GetComponent(
+            playerEntityID,
+            g.ComponentRegistry.GetIDByName("Weapon"),
+        ); has {
+            weapon := weaponComp.(*components.Weapon)
+            if weapon.HasAny() {
+                g.WeaponName = fmt.Sprintf("%s LV%d", weapon.Definition().Name, weapon.Levels[weapon.Current])
+            }
+        }
+        
+        // List effects in a fixed order so the HUD bars don't jump around
+        g.Effects = g.Effects[:0]
+        if effectsComp, has := g.EntityManager.GetComponent(
+            playerEntityID,
+            g.ComponentRegistry.GetIDByName("ActiveEffects"),
+        ); has {
+            effects := effectsComp.(*components.ActiveEffects)
+            for effectType := components.EffectSpeedBoost; effectType <= components.EffectDoubleScore; effectType++ {
+                effect, running := effects.Effects[effectType]
+                if !running {
+                    continue
+                }
+                def := components.EffectDefs[effectType]
+                g.Effects = append(g.Effects, models.EffectTimer{
+                    Name:      def.Name,
+                    Remaining: effect.Remaining,
+                    Duration:  effect.Duration,
+                    Stacks:    effect.Stacks,
+                    Color:     def.Color,
+                })
+            }
+        }
     }
     
-    // Update scientists rescued count
-    // In a real implementation, we would have a component or system for tracking this
-    
     // Check if boss is defeated
     if g.IsBossLevel && !g.BossDefeated {
         bossEntities := g.EntityManager.GetEntitiesWithComponents(
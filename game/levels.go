@@ -0,0 +1,78 @@
+// game/levels.go
+package game
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "atomblaster/systems"
+)
+
+// LevelConfig describes the layout of a single level
+type LevelConfig struct {
+    WorldWidth  float32
+    WorldHeight float32
+    BossLevel   bool    // Boss levels skip atoms and scientists and open the door when the boss dies
+    ParTime     float32 // Seconds to beat for a time bonus
+}
+
+// levelConfigs holds the layout of every level, indexed by level number - 1.
+// Early levels fit on one screen; later ones scroll in one or both directions.
+var levelConfigs = []LevelConfig{
+    {WorldWidth: constants.ScreenWidth, WorldHeight: constants.ScreenHeight, ParTime: 60},              // Level 1
+    {WorldWidth: constants.ScreenWidth * 1.5, WorldHeight: constants.ScreenHeight, ParTime: 75},        // Level 2
+    {WorldWidth: constants.ScreenWidth * 2, WorldHeight: constants.ScreenHeight, ParTime: 90},          // Level 3
+    {WorldWidth: constants.ScreenWidth * 2, WorldHeight: constants.ScreenHeight * 1.5, ParTime: 110},   // Level 4
+    {WorldWidth: constants.ScreenWidth * 1.5, WorldHeight: constants.ScreenHeight * 1.5, BossLevel: true, ParTime: 120}, // Level 5 (boss arena)
+    {WorldWidth: constants.ScreenWidth * 2.5, WorldHeight: constants.ScreenHeight * 1.5, ParTime: 130}, // Level 6
+    {WorldWidth: constants.ScreenWidth * 2.5, WorldHeight: constants.ScreenHeight * 2, ParTime: 150},   // Level 7
+    {WorldWidth: constants.ScreenWidth * 3, WorldHeight: constants.ScreenHeight * 2, ParTime: 170},     // Level 8
+    {WorldWidth: constants.ScreenWidth * 3, WorldHeight: constants.ScreenHeight * 2.5, ParTime: 190},   // Level 9
+    {WorldWidth: constants.ScreenWidth * 3.5, WorldHeight: constants.ScreenHeight * 2.5, BossLevel: true, ParTime: 240}, // Level 10 (final boss)
+}
+
+// getLevelConfig returns the configuration for the given level,
+// falling back to the nearest defined level when out of range
+func getLevelConfig(level int) LevelConfig {
+    if level < 1 {
+        level = 1
+    }
+    if level > len(levelConfigs) {
+        level = len(levelConfigs)
+    }
+    return levelConfigs[level-1]
+}
+
+// PlayerUpgrades holds the player improvements that carry over between levels
+type PlayerUpgrades struct {
+    Weapons       map[components.WeaponType]int // Upgrade level of every owned weapon
+    CurrentWeapon components.WeaponType
+    Speed         float32
+}
+
+// defaultPlayerUpgrades returns the upgrades a new run starts with
+func defaultPlayerUpgrades() PlayerUpgrades {
+    return PlayerUpgrades{
+        Weapons:       make(map[components.WeaponType]int),
+        CurrentWeapon: components.WeaponBlaster,
+        Speed:         constants.PlayerInitialSpeed,
+    }
+}
+
+// copy returns a copy of the upgrades that doesn't share the weapon map
+func (u PlayerUpgrades) copy() PlayerUpgrades {
+    weapons := make(map[components.WeaponType]int, len(u.Weapons))
+    for weaponType, level := range u.Weapons {
+        weapons[weaponType] = level
+    }
+    u.Weapons = weapons
+    return u
+}
+
+// Checkpoint is the state of a run at the start of a level, which a continue goes back to
+type Checkpoint struct {
+    Level     int
+    Score     int
+    Health    int
+    Upgrades  PlayerUpgrades
+    Breakdown systems.ScoreBreakdown
+}
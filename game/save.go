@@ -0,0 +1,205 @@
+// game/save.go
+package game
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "atomblaster/storage"
+    "atomblaster/systems"
+    "atomblaster/ui/models"
+    "atomblaster/util"
+    "errors"
+    "fmt"
+    "os"
+    "time"
+
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// saveVersion is the current save format. Bump it when SaveGame changes and teach
+// upgradeSave how to bring older files up to date.
+const saveVersion = 1
+
+// SaveGame is a campaign saved at the start of a level
+type SaveGame struct {
+    Level         int                           `json:"level"`
+    Score         int                           `json:"score"`
+    Health        int                           `json:"health"`
+    Lives         int                           `json:"lives"`
+    Weapons       map[components.WeaponType]int `json:"weapons"` // Upgrade level of every owned weapon
+    CurrentWeapon components.WeaponType         `json:"current_weapon"`
+    Speed         float32                       `json:"speed"`
+    Difficulty    string                        `json:"difficulty"` // Name of the difficulty preset
+    Adaptive      bool                          `json:"adaptive"` // Whether the difficulty director is on
+    Director      systems.DirectorAdjustment    `json:"director"` // Director adjustments at the start of the level
+    Breakdown     systems.ScoreBreakdown        `json:"breakdown"`
+    RNG           util.RNGState                 `json:"rng"` // Position before the level was built, so it is rebuilt the same
+    ElapsedTime   int64                         `json:"elapsed_time"`
+    Date          time.Time                     `json:"date"`
+}
+
+// upgradeSave brings a save written by an older version of the game up to date. There
+// are no older formats yet, so it only fills in what a partial file is missing.
+func upgradeSave(version int, save *SaveGame) {
+    if save.Level < 1 {
+        save.Level = 1
+    }
+    if _, known := systems.DifficultyByName(save.Difficulty); !known {
+        save.Difficulty = "Normal"
+    }
+    if save.Health <= 0 {
+        difficulty, _ := systems.DifficultyByName(save.Difficulty)
+        save.Health = difficulty.PlayerHealth
+    }
+    if save.Lives <= 0 {
+        save.Lives = constants.StartingLives
+    }
+    if save.Speed <= 0 {
+        save.Speed = constants.PlayerInitialSpeed
+    }
+    if save.Weapons == nil {
+        save.Weapons = make(map[components.WeaponType]int)
+    }
+}
+
+// readSave loads the campaign in a slot, upgrading it to the current format
+func readSave(slot int) (*SaveGame, error) {
+    save := &SaveGame{}
+    version, err := storage.ReadSave(slot, save)
+    if err != nil {
+        return nil, err
+    }
+    if version > saveVersion {
+        return nil, fmt.Errorf("save slot %d was written by a newer version of the game", slot+1)
+    }
+    
+    upgradeSave(version, save)
+    return save, nil
+}
+
+// autosave writes the campaign to its save slot at the start of a level
+func (g *GameState) autosave() {
+    if g.SaveSlot < 0 {
+        return
+    }
+    
+    save := SaveGame{
+        Level:         g.Level,
+        Score:         g.Score,
+        Health:        g.Health,
+        Lives:         g.Lives,
+        Weapons:       g.Upgrades.copy().Weapons,
+        CurrentWeapon: g.Upgrades.CurrentWeapon,
+        Speed:         g.Upgrades.Speed,
+        Difficulty:    g.Difficulty.Name,
+        Adaptive:      g.Adaptive,
+        Director:      g.Director.Adjustment,
+        Breakdown:     g.ScoreSystem.Breakdown,
+        RNG:           g.RNG.State(),
+        ElapsedTime:   g.ElapsedTime,
+        Date:          time.Now(),
+    }
+    if err := storage.WriteSave(g.SaveSlot, saveVersion, save); err != nil {
+        rl.TraceLog(rl.LogWarning, "autosave: %v", err)
+    }
+    
+    g.refreshSaveSlots()
+}
+
+// NewCampaign starts a new run in the first empty save slot, or over the oldest save.
+// Slots that can't be read are left alone, so a damaged or newer save isn't lost.
+func (g *GameState) NewCampaign() {
+    g.SaveSlot = -1
+    var oldest time.Time
+    for slot := 0; slot < storage.SaveSlots; slot++ {
+        save, err := readSave(slot)
+        if errors.Is(err, os.ErrNotExist) {
+            g.SaveSlot = slot
+            break
+        }
+        if err != nil {
+            continue
+        }
+        if oldest.IsZero() || save.Date.Before(oldest) {
+            oldest = save.Date
+            g.SaveSlot = slot
+        }
+    }
+    if g.SaveSlot < 0 {
+        rl.TraceLog(rl.LogWarning, "no usable save slot, this campaign won't be saved")
+    }
+    
+    g.ResetGame()
+}
+
+// LoadGame resumes the campaign saved in a slot
+func (g *GameState) LoadGame(slot int) error {
+    save, err := readSave(slot)
+    if err != nil {
+        return err
+    }
+    
+    g.Difficulty, _ = systems.DifficultyByName(save.Difficulty)
+    g.Adaptive = save.Adaptive
+    g.TitleModel.DifficultyName = g.Difficulty.Name
+    g.TitleModel.Adaptive = g.Adaptive
+    g.resetRun()
+    if save.Adaptive && save.Director.EnemyCount > 0 {
+        g.Director.Adjustment = save.Director
+    }
+    g.SaveSlot = slot
+    g.Level = save.Level
+    g.Score = save.Score
+    g.Health = save.Health
+    g.Lives = save.Lives
+    g.Upgrades = PlayerUpgrades{
+        Weapons:       save.Weapons,
+        CurrentWeapon: save.CurrentWeapon,
+        Speed:         save.Speed,
+    }
+    g.ScoreSystem.Restore(save.Breakdown, 0)
+    g.RNG.Restore(save.RNG)
+    g.StartTime = int64(rl.GetTime()) - save.ElapsedTime
+    g.ElapsedTime = save.ElapsedTime
+    
+    g.EntityManager.DestroyAllEntities()
+    g.initLevel()
+    return nil
+}
+
+// refreshSaveSlots updates the save slot list and the title menu's Continue entry
+func (g *GameState) refreshSaveSlots() {
+    if g.SaveSlotsModel == nil {
+        return
+    }
+    
+    slots := make([]models.SaveSlotInfo, storage.SaveSlots)
+    newest := -1
+    for slot := range slots {
+        save, err := readSave(slot)
+        if err != nil {
+            if !errors.Is(err, os.ErrNotExist) {
+                rl.TraceLog(rl.LogWarning, "save slot %d: %v", slot+1, err)
+                slots[slot].Error = err.Error()
+            }
+            continue
+        }
+        
+        slots[slot] = models.SaveSlotInfo{
+            Used:       true,
+            Level:      save.Level,
+            Score:      save.Score,
+            Date:       save.Date,
+            Difficulty: save.Difficulty,
+        }
+        if newest < 0 || save.Date.After(slots[newest].Date) {
+            newest = slot
+        }
+    }
+    
+    g.SaveSlotsModel.Slots = slots
+    if newest >= 0 {
+        g.SaveSlotsModel.SelectedItem = newest
+    }
+    g.TitleModel.HasSaves = newest >= 0
+}
@@ -0,0 +1,96 @@
+// game/stats.go
+package game
+
+import (
+    "atomblaster/systems"
+    "atomblaster/storage"
+    "atomblaster/ui/models"
+    "fmt"
+    "sort"
+    "time"
+
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// runRecord is the file written for each run, so balance changes can be compared across runs
+type runRecord struct {
+    Started     time.Time                  `json:"started"`
+    Seed        int64                      `json:"seed"`
+    Difficulty  string                     `json:"difficulty"`
+    Adaptive    bool                       `json:"adaptive"`
+    DirectorLog []systems.DirectorLogEntry `json:"director_log,omitempty"` // Changes the difficulty director made
+    Won         bool                       `json:"won"`
+    Score       int                        `json:"score"`
+    Level       int                        `json:"level"` // Level reached
+    TimeSeconds int64                      `json:"time_seconds"`
+    Stats       systems.RunStats           `json:"stats"`
+}
+
+// finishRunStats closes the run's statistics, fills in the results screen and writes the run file
+func (g *GameState) finishRunStats(won bool) {
+    g.StatsSystem.Finish()
+    g.GameOverModel.Stats = g.statLines()
+    
+    path, err := storage.WriteRunStats(g.runStarted, runRecord{
+        Started:     g.runStarted,
+        Seed:        g.RNG.Seed(),
+        Difficulty:  g.Difficulty.Name,
+        Adaptive:    g.Adaptive,
+        DirectorLog: g.Director.Log,
+        Won:         won,
+        Score:       g.Score,
+        Level:       g.Level,
+        TimeSeconds: g.ElapsedTime,
+        Stats:       g.StatsSystem.Stats,
+    })
+    if err != nil {
+        rl.TraceLog(rl.LogWarning, "run stats: %v", err)
+        return
+    }
+    rl.TraceLog(rl.LogInfo, "run stats written to %s", path)
+}
+
+// statLines summarizes the run's statistics for the results screen: overall numbers
+// first, then the most destroyed enemy types and the average level time
+func (g *GameState) statLines() []models.StatLine {
+    stats := g.StatsSystem.Stats
+    lines := []models.StatLine{
+        {Label: "Accuracy", Value: fmt.Sprintf("%.0f%% (%d/%d)", stats.Accuracy()*100, stats.ShotsHit, stats.ShotsFired)},
+        {Label: "Damage Taken", Value: fmt.Sprint(stats.DamageTaken)},
+        {Label: "Deaths", Value: fmt.Sprint(stats.Deaths)},
+        {Label: "Dashes", Value: fmt.Sprint(stats.Dashes)},
+        {Label: "Power-Ups", Value: fmt.Sprint(stats.TotalPowerUps())},
+        {Label: "Kills", Value: fmt.Sprint(stats.TotalKills())},
+    }
+    
+    // Enemy types with the most kills
+    kinds := make([]string, 0, len(stats.Kills))
+    for kind := range stats.Kills {
+        kinds = append(kinds, kind)
+    }
+    sort.Slice(kinds, func(i, j int) bool {
+        if stats.Kills[kinds[i]] != stats.Kills[kinds[j]] {
+            return stats.Kills[kinds[i]] > stats.Kills[kinds[j]]
+        }
+        return kinds[i] < kinds[j]
+    })
+    for i := 0; i < len(kinds) && i < 3; i++ {
+        lines = append(lines, models.StatLine{Label: "  " + kinds[i], Value: fmt.Sprint(stats.Kills[kinds[i]])})
+    }
+    
+    // Average time of the levels that were cleared
+    var total float32
+    cleared := 0
+    for _, level := range stats.LevelTimes {
+        if level.Completed {
+            total += level.Seconds
+            cleared++
+        }
+    }
+    if cleared > 0 {
+        average := int(total / float32(cleared))
+        lines = append(lines, models.StatLine{Label: "Avg Level Time", Value: fmt.Sprintf("%02d:%02d", average/60, average%60)})
+    }
+    
+    return lines
+}
@@ -1,11 +1,11 @@
 module atomblaster
 
-
 go 1.24.2
 
+require github.com/gen2brain/raylib-go/raylib v0.0.0-20250409052854-a4292f0f0412
+
 require (
 	github.com/ebitengine/purego v0.8.2 // indirect
-	github.com/gen2brain/raylib-go/raylib v0.0.0-20250409052854-a4292f0f0412 // indirect
 	golang.org/x/exp v0.0.0-20250408133849-7e4ce0ab07d0 // indirect
 	golang.org/x/sys v0.32.0 // indirect
 )
@@ -13,6 +13,7 @@ func main() {
 	// Initialize window
 	rl.InitWindow(constants.ScreenWidth, constants.ScreenHeight, "Atom Blaster")
 	rl.SetTargetFPS(60)
+	rl.SetExitKey(0) // Esc goes back in menus, so it must not close the window
 	
 	// Initialize audio
 	rl.InitAudioDevice()
@@ -0,0 +1,61 @@
+// storage/achievements.go
+package storage
+
+import (
+    "errors"
+    "os"
+    "time"
+)
+
+const (
+    achievementsFile    = "achievements.json"
+    achievementsVersion = 1
+)
+
+// AchievementProgress is the player's achievement progress, kept across sessions
+type AchievementProgress struct {
+    Counters map[string]int       `json:"counters"` // Progress toward each achievement, by ID
+    Unlocked map[string]time.Time `json:"unlocked"` // When each unlocked achievement was earned, by ID
+}
+
+// newAchievementProgress returns progress with nothing earned yet
+func newAchievementProgress() *AchievementProgress {
+    return &AchievementProgress{
+        Counters: make(map[string]int),
+        Unlocked: make(map[string]time.Time),
+    }
+}
+
+// LoadAchievements reads achievement progress from the data directory. Like
+// LoadHighScores, a missing file starts fresh and a tampered file is ignored with ErrTampered.
+func LoadAchievements() (*AchievementProgress, error) {
+    progress := newAchievementProgress()
+
+    _, err := readFile(achievementsFile, progress)
+    if errors.Is(err, os.ErrNotExist) {
+        return progress, nil
+    }
+    if err != nil {
+        return newAchievementProgress(), err
+    }
+
+    // Files with no entries decode the maps as nil
+    if progress.Counters == nil {
+        progress.Counters = make(map[string]int)
+    }
+    if progress.Unlocked == nil {
+        progress.Unlocked = make(map[string]time.Time)
+    }
+    return progress, nil
+}
+
+// Save writes achievement progress to the data directory
+func (p *AchievementProgress) Save() error {
+    return writeFile(achievementsFile, achievementsVersion, p)
+}
+
+// IsUnlocked reports whether an achievement has been earned
+func (p *AchievementProgress) IsUnlocked(id string) bool {
+    _, unlocked := p.Unlocked[id]
+    return unlocked
+}
@@ -0,0 +1,52 @@
+// storage/achievements_test.go
+package storage
+
+import (
+    "testing"
+    "time"
+)
+
+func TestAchievementsCarryOver(t *testing.T) {
+    useTempDataDir(t)
+
+    // First session: nothing saved yet
+    progress, err := LoadAchievements()
+    if err != nil {
+        t.Fatalf("LoadAchievements: %v", err)
+    }
+    if len(progress.Counters) != 0 || len(progress.Unlocked) != 0 {
+        t.Fatalf("fresh progress = %+v, want empty", progress)
+    }
+
+    unlockedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+    progress.Counters["atoms_destroyed"] = 42
+    progress.Unlocked["first_blood"] = unlockedAt
+    if err := progress.Save(); err != nil {
+        t.Fatalf("Save: %v", err)
+    }
+
+    // Second session picks up where the first left off
+    loaded, err := LoadAchievements()
+    if err != nil {
+        t.Fatalf("LoadAchievements after save: %v", err)
+    }
+    if loaded.Counters["atoms_destroyed"] != 42 {
+        t.Errorf("counter = %d, want 42", loaded.Counters["atoms_destroyed"])
+    }
+    if !loaded.IsUnlocked("first_blood") || !loaded.Unlocked["first_blood"].Equal(unlockedAt) {
+        t.Errorf("unlocked = %v, want first_blood at %v", loaded.Unlocked, unlockedAt)
+    }
+
+    // Saving again keeps the earlier progress
+    loaded.Counters["atoms_destroyed"]++
+    if err := loaded.Save(); err != nil {
+        t.Fatalf("second Save: %v", err)
+    }
+    again, err := LoadAchievements()
+    if err != nil {
+        t.Fatalf("third LoadAchievements: %v", err)
+    }
+    if again.Counters["atoms_destroyed"] != 43 || !again.IsUnlocked("first_blood") {
+        t.Errorf("progress after second save = %+v", again)
+    }
+}
@@ -0,0 +1,116 @@
+// storage/highscores.go
+package storage
+
+import (
+    "errors"
+    "os"
+    "sort"
+    "time"
+)
+
+const (
+    highScoresFile    = "highscores.json"
+    highScoresVersion = 1
+    MaxHighScores     = 10 // entries kept in the table
+    MaxNameLength     = 12 // characters allowed in a player name
+)
+
+// HighScoreEntry is one finished run on the leaderboard
+type HighScoreEntry struct {
+    Name        string    `json:"name"`
+    Score       int       `json:"score"`
+    Level       int       `json:"level"`        // Level reached
+    TimeSeconds int64     `json:"time_seconds"` // Length of the run
+    RescueRate  int       `json:"rescue_rate"`  // Percentage of scientists rescued
+    Difficulty  string    `json:"difficulty"`
+    Date        time.Time `json:"date"`
+}
+
+// HighScoreTable is the local leaderboard, best score first
+type HighScoreTable struct {
+    Entries []HighScoreEntry `json:"entries"`
+}
+
+// LoadHighScores reads the leaderboard from the data directory. A missing file gives
+// an empty table. A file that fails its checksum is ignored: the empty table is
+// returned along with ErrTampered, and the file is replaced on the next save.
+func LoadHighScores() (*HighScoreTable, error) {
+    table := &HighScoreTable{}
+
+    _, err := readFile(highScoresFile, table)
+    if errors.Is(err, os.ErrNotExist) {
+        return table, nil
+    }
+    if err != nil {
+        return &HighScoreTable{}, err
+    }
+
+    table.sort()
+    if len(table.Entries) > MaxHighScores {
+        table.Entries = table.Entries[:MaxHighScores]
+    }
+    return table, nil
+}
+
+// Save writes the leaderboard to the data directory
+func (t *HighScoreTable) Save() error {
+    return writeFile(highScoresFile, highScoresVersion, t)
+}
+
+// Qualifies reports whether a score would make it onto the leaderboard
+func (t *HighScoreTable) Qualifies(score int) bool {
+    if score <= 0 {
+        return false
+    }
+    if len(t.Entries) < MaxHighScores {
+        return true
+    }
+    return score > t.Entries[len(t.Entries)-1].Score
+}
+
+// Insert adds an entry to the leaderboard and returns its rank, starting at 1,
+// or 0 if it didn't make the cut. Ties go to the earlier entry.
+func (t *HighScoreTable) Insert(entry HighScoreEntry) int {
+    if !t.Qualifies(entry.Score) {
+        return 0
+    }
+
+    if len(entry.Name) > MaxNameLength {
+        entry.Name = entry.Name[:MaxNameLength]
+    }
+
+    rank := len(t.Entries)
+    for i, existing := range t.Entries {
+        if entry.Score > existing.Score {
+            rank = i
+            break
+        }
+    }
+
+    t.Entries = append(t.Entries, HighScoreEntry{})
+    copy(t.Entries[rank+1:], t.Entries[rank:])
+    t.Entries[rank] = entry
+
+    if len(t.Entries) > MaxHighScores {
+        t.Entries = t.Entries[:MaxHighScores]
+    }
+    return rank + 1
+}
+
+// Remove deletes the entry recorded at the given date, e.g. when a continued run
+// submits its new score over the one it entered before
+func (t *HighScoreTable) Remove(date time.Time) {
+    for i, entry := range t.Entries {
+        if entry.Date.Equal(date) {
+            t.Entries = append(t.Entries[:i], t.Entries[i+1:]...)
+            return
+        }
+    }
+}
+
+// sort orders the entries best score first, keeping earlier entries ahead on ties
+func (t *HighScoreTable) sort() {
+    sort.SliceStable(t.Entries, func(i, j int) bool {
+        return t.Entries[i].Score > t.Entries[j].Score
+    })
+}
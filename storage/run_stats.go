@@ -0,0 +1,35 @@
+// storage/run_stats.go
+package storage
+
+import (
+    "encoding/json"
+    "os"
+    "path/filepath"
+    "time"
+)
+
+// runStatsDir is the folder inside the data directory that run statistics go in
+const runStatsDir = "runs"
+
+// WriteRunStats saves a run's statistics as plain JSON, one file per run named after
+// the time the run started, so later writes for the same run replace the earlier ones.
+// It returns the path of the file.
+func WriteRunStats(started time.Time, value interface{}) (string, error) {
+    base, err := DataDir()
+    if err != nil {
+        return "", err
+    }
+
+    dir := filepath.Join(base, runStatsDir)
+    if err := os.MkdirAll(dir, 0o755); err != nil {
+        return "", err
+    }
+
+    contents, err := json.MarshalIndent(value, "", "  ")
+    if err != nil {
+        return "", err
+    }
+
+    path := filepath.Join(dir, "run-"+started.Format("20060102-150405")+".json")
+    return path, os.WriteFile(path, contents, 0o644)
+}
@@ -0,0 +1,32 @@
+// storage/saves.go
+package storage
+
+import (
+    "fmt"
+)
+
+// SaveSlots is the number of campaigns that can be saved side by side
+const SaveSlots = 3
+
+// saveFileName returns the file a save slot is kept in
+func saveFileName(slot int) string {
+    return fmt.Sprintf("save%d.json", slot+1)
+}
+
+// WriteSave saves a campaign to a slot, tagged with the format version it was written in
+func WriteSave(slot, version int, value interface{}) error {
+    if slot < 0 || slot >= SaveSlots {
+        return fmt.Errorf("storage: no save slot %d", slot)
+    }
+    return writeFile(saveFileName(slot), version, value)
+}
+
+// ReadSave loads the campaign in a slot into value and returns the format version it was
+// written in, so older saves can be upgraded. An empty slot returns an error wrapping
+// os.ErrNotExist.
+func ReadSave(slot int, value interface{}) (int, error) {
+    if slot < 0 || slot >= SaveSlots {
+        return 0, fmt.Errorf("storage: no save slot %d", slot)
+    }
+    return readFile(saveFileName(slot), value)
+}
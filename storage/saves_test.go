@@ -0,0 +1,50 @@
+// storage/saves_test.go
+package storage
+
+import (
+    "errors"
+    "os"
+    "testing"
+)
+
+func TestSaveRoundTrip(t *testing.T) {
+    useTempDataDir(t)
+
+    type campaign struct {
+        Level int    `json:"level"`
+        Score int    `json:"score"`
+        Name  string `json:"name"`
+    }
+    in := campaign{Level: 4, Score: 1250, Name: "Hard"}
+    if err := WriteSave(1, 3, in); err != nil {
+        t.Fatalf("WriteSave: %v", err)
+    }
+
+    var out campaign
+    version, err := ReadSave(1, &out)
+    if err != nil {
+        t.Fatalf("ReadSave: %v", err)
+    }
+    if version != 3 {
+        t.Errorf("version = %d, want 3", version)
+    }
+    if out != in {
+        t.Errorf("read back %+v, want %+v", out, in)
+    }
+
+    // Other slots are untouched
+    if _, err := ReadSave(0, &out); !errors.Is(err, os.ErrNotExist) {
+        t.Errorf("slot 0 err = %v, want os.ErrNotExist", err)
+    }
+}
+
+func TestSaveSlotOutOfRange(t *testing.T) {
+    useTempDataDir(t)
+
+    if err := WriteSave(SaveSlots, 1, struct{}{}); err == nil {
+        t.Error("WriteSave past the last slot succeeded")
+    }
+    if _, err := ReadSave(-1, &struct{}{}); err == nil {
+        t.Error("ReadSave of slot -1 succeeded")
+    }
+}
@@ -0,0 +1,113 @@
+// storage/userdata.go
+package storage
+
+import (
+    "crypto/hmac"
+    "bytes"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "errors"
+    "os"
+    "path/filepath"
+)
+
+// appDirName is the folder created for the game inside the user's config directory
+const appDirName = "atomblaster"
+
+// checksumKey signs saved files. It only has to make casual edits to a file detectable,
+// so it is fine for it to ship with the game.
+var checksumKey = []byte("atomblaster-local-records")
+
+// ErrTampered is returned when a saved file doesn't match its checksum
+var ErrTampered = errors.New("storage: checksum mismatch, file was modified")
+
+// envelope is the on-disk layout of every file written by this package
+type envelope struct {
+    Version  int             `json:"version"`
+    Checksum string          `json:"checksum"`
+    Data     json.RawMessage `json:"data"`
+}
+
+// DataDir returns the directory the game keeps its files in, creating it if needed
+func DataDir() (string, error) {
+    base, err := os.UserConfigDir()
+    if err != nil {
+        return "", err
+    }
+
+    dir := filepath.Join(base, appDirName)
+    if err := os.MkdirAll(dir, 0o755); err != nil {
+        return "", err
+    }
+    return dir, nil
+}
+
+// checksum signs a file's version and data
+func checksum(version int, data []byte) string {
+    mac := hmac.New(sha256.New, checksumKey)
+    mac.Write([]byte{byte(version >> 8), byte(version)})
+    mac.Write(data)
+    return hex.EncodeToString(mac.Sum(nil))
+}
+
+// writeFile saves a value to a file in the data directory with a version and checksum.
+// The file is written to a temporary file first, so a crash can't leave it half written.
+func writeFile(name string, version int, value interface{}) error {
+    dir, err := DataDir()
+    if err != nil {
+        return err
+    }
+
+    data, err := json.Marshal(value)
+    if err != nil {
+        return err
+    }
+
+    contents, err := json.MarshalIndent(envelope{
+        Version:  version,
+        Checksum: checksum(version, data),
+        Data:     data,
+    }, "", "  ")
+    if err != nil {
+        return err
+    }
+
+    path := filepath.Join(dir, name)
+    tmp := path + ".tmp"
+    if err := os.WriteFile(tmp, contents, 0o644); err != nil {
+        return err
+    }
+    return os.Rename(tmp, path)
+}
+
+// readFile loads a file written by writeFile into value and returns the version it was
+// saved with. It returns an error wrapping os.ErrNotExist if the file doesn't exist, and
+// ErrTampered if the contents don't match the checksum.
+func readFile(name string, value interface{}) (int, error) {
+    dir, err := DataDir()
+    if err != nil {
+        return 0, err
+    }
+
+    contents, err := os.ReadFile(filepath.Join(dir, name))
+    if err != nil {
+        return 0, err
+    }
+
+    var env envelope
+    if err := json.Unmarshal(contents, &env); err != nil {
+        return 0, err
+    }
+
+    // The file is indented, but the checksum was taken over the compact data
+    var data bytes.Buffer
+    if err := json.Compact(&data, env.Data); err != nil {
+        return 0, err
+    }
+    if !hmac.Equal([]byte(env.Checksum), []byte(checksum(env.Version, data.Bytes()))) {
+        return env.Version, ErrTampered
+    }
+
+    return env.Version, json.Unmarshal(data.Bytes(), value)
+}
@@ -0,0 +1,108 @@
+// storage/userdata_test.go
+package storage
+
+import (
+    "errors"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+    "time"
+)
+
+// useTempDataDir points DataDir at a fresh directory for the test
+func useTempDataDir(t *testing.T) string {
+    t.Helper()
+    base := t.TempDir()
+    t.Setenv("XDG_CONFIG_HOME", base)
+    t.Setenv("HOME", base)
+    t.Setenv("AppData", base)
+
+    dir, err := DataDir()
+    if err != nil {
+        t.Fatalf("DataDir: %v", err)
+    }
+    return dir
+}
+
+func TestFileRoundTrip(t *testing.T) {
+    useTempDataDir(t)
+
+    type record struct {
+        Name   string         `json:"name"`
+        Values map[string]int `json:"values"`
+    }
+    in := record{Name: "test", Values: map[string]int{"a": 1, "b": 2}}
+    if err := writeFile("test.json", 7, in); err != nil {
+        t.Fatalf("writeFile: %v", err)
+    }
+
+    var out record
+    version, err := readFile("test.json", &out)
+    if err != nil {
+        t.Fatalf("readFile: %v", err)
+    }
+    if version != 7 {
+        t.Errorf("version = %d, want 7", version)
+    }
+    if out.Name != in.Name || out.Values["a"] != 1 || out.Values["b"] != 2 {
+        t.Errorf("read back %+v, want %+v", out, in)
+    }
+}
+
+func TestReadFileMissing(t *testing.T) {
+    useTempDataDir(t)
+
+    var out struct{}
+    if _, err := readFile("missing.json", &out); !errors.Is(err, os.ErrNotExist) {
+        t.Errorf("err = %v, want os.ErrNotExist", err)
+    }
+}
+
+func TestReadFileTampered(t *testing.T) {
+    dir := useTempDataDir(t)
+
+    if err := writeFile("test.json", 1, map[string]int{"score": 100}); err != nil {
+        t.Fatalf("writeFile: %v", err)
+    }
+
+    path := filepath.Join(dir, "test.json")
+    contents, err := os.ReadFile(path)
+    if err != nil {
+        t.Fatal(err)
+    }
+    edited := strings.Replace(string(contents), "100", "999", 1)
+    if err := os.WriteFile(path, []byte(edited), 0o644); err != nil {
+        t.Fatal(err)
+    }
+
+    var out map[string]int
+    if _, err := readFile("test.json", &out); !errors.Is(err, ErrTampered) {
+        t.Errorf("err = %v, want ErrTampered", err)
+    }
+}
+
+func TestHighScoresRoundTrip(t *testing.T) {
+    useTempDataDir(t)
+
+    table, err := LoadHighScores()
+    if err != nil {
+        t.Fatalf("LoadHighScores: %v", err)
+    }
+    table.Insert(HighScoreEntry{Name: "AAA", Score: 500, Level: 3, Difficulty: "Normal", Date: time.Now()})
+    table.Insert(HighScoreEntry{Name: "BBB", Score: 900, Level: 5, Difficulty: "Hard", Date: time.Now()})
+    if err := table.Save(); err != nil {
+        t.Fatalf("Save: %v", err)
+    }
+
+    loaded, err := LoadHighScores()
+    if err != nil {
+        t.Fatalf("LoadHighScores after save: %v", err)
+    }
+    if len(loaded.Entries) != 2 {
+        t.Fatalf("loaded %d entries, want 2", len(loaded.Entries))
+    }
+    if loaded.Entries[0].Name != "BBB" || loaded.Entries[0].Score != 900 {
+        t.Errorf("top entry = %+v, want BBB with 900", loaded.Entries[0])
+    }
+}
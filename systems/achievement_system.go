@@ -0,0 +1,114 @@
+// systems/achievement_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/storage"
+    "time"
+)
+
+// AchievementSystem advances the achievements in Achievements from gameplay events
+// and records progress that persists between sessions
+type AchievementSystem struct {
+    progress      *storage.AchievementProgress
+    onUnlock      func(Achievement) // Called once when an achievement is earned
+    damaged       bool              // Whether the player was hurt on the current level
+    rescued       int               // Scientists rescued on the current level
+    scientistLost bool              // Whether a scientist died on the current level
+}
+
+// NewAchievementSystem creates a new achievement system
+func NewAchievementSystem(progress *storage.AchievementProgress, events *EventBus, onUnlock func(Achievement)) *AchievementSystem {
+    s := &AchievementSystem{
+        progress: progress,
+        onUnlock: onUnlock,
+    }
+
+    if events != nil {
+        // Level state is tracked first so conditions see the event that changed it
+        events.Subscribe(EventPlayerDamaged, s.handlePlayerHurt)
+        events.Subscribe(EventPlayerDied, s.handlePlayerHurt)
+        events.Subscribe(EventScientistRescued, func(Event) { s.rescued++ })
+        events.Subscribe(EventScientistKilled, func(Event) { s.scientistLost = true })
+
+        // Subscribe once per event type any achievement listens to
+        subscribed := make(map[EventType]bool)
+        for _, achievement := range Achievements {
+            if !subscribed[achievement.Event] {
+                subscribed[achievement.Event] = true
+                events.Subscribe(achievement.Event, s.handleEvent)
+            }
+        }
+    }
+
+    return s
+}
+
+// StartLevel clears the per-level state the level conditions check
+func (s *AchievementSystem) StartLevel() {
+    s.damaged = false
+    s.rescued = 0
+    s.scientistLost = false
+}
+
+// Progress returns how far along an achievement is, capped at its goal
+func (s *AchievementSystem) Progress(achievement Achievement) int {
+    count := s.progress.Counters[achievement.ID]
+    if count > achievement.Goal || s.progress.IsUnlocked(achievement.ID) {
+        count = achievement.Goal
+    }
+    return count
+}
+
+// handlePlayerHurt notes that the player was hurt on this level
+func (s *AchievementSystem) handlePlayerHurt(e Event) {
+    s.damaged = true
+}
+
+// handleEvent counts an event toward every achievement it advances
+func (s *AchievementSystem) handleEvent(e Event) {
+    for _, achievement := range Achievements {
+        if achievement.Event != e.Type || s.progress.IsUnlocked(achievement.ID) {
+            continue
+        }
+        if !achievement.matches(e) || !s.conditionMet(achievement.Condition) {
+            continue
+        }
+
+        s.progress.Counters[achievement.ID]++
+        if s.progress.Counters[achievement.ID] >= achievement.Goal {
+            s.progress.Unlocked[achievement.ID] = time.Now()
+            delete(s.progress.Counters, achievement.ID)
+            if s.onUnlock != nil {
+                s.onUnlock(achievement)
+            }
+        }
+    }
+}
+
+// conditionMet checks a level condition against the current level
+func (s *AchievementSystem) conditionMet(condition LevelCondition) bool {
+    switch condition {
+    case NoDamageTaken:
+        return !s.damaged
+    case NoScientistLost:
+        return s.rescued > 0 && !s.scientistLost
+    default:
+        return true
+    }
+}
+
+// Update does nothing; achievements advance entirely through events
+func (s *AchievementSystem) Update(dt float32) {
+    // Achievement system is event driven
+}
+
+// Draw is empty for AchievementSystem; unlocks are shown as toasts by the UI
+func (s *AchievementSystem) Draw() {
+    // Achievement system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *AchievementSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{}
+}
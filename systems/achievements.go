@@ -0,0 +1,90 @@
+// systems/achievements.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+)
+
+// ValueMatch says which event values count toward an achievement
+type ValueMatch int
+
+const (
+    AnyValue     ValueMatch = iota // Every event counts
+    ValueEquals                    // Only events whose Value equals the achievement's Value
+    ValueAtLeast                   // Only events whose Value is at least the achievement's Value
+)
+
+// LevelCondition is an extra requirement on the current level, checked when a counted event fires
+type LevelCondition int
+
+const (
+    NoCondition     LevelCondition = iota
+    NoDamageTaken                  // The player hasn't been hurt on this level
+    NoScientistLost                // At least one scientist was rescued and none were killed on this level
+)
+
+// Achievement describes one achievement: the event that advances it, which of those
+// events count, and how many are needed to unlock it
+type Achievement struct {
+    ID          string // Stable key for saved progress; never change it once shipped
+    Name        string
+    Description string
+    Event       EventType
+    Match       ValueMatch
+    Value       int
+    Condition   LevelCondition
+    Goal        int // Counted events needed to unlock
+}
+
+// Achievements lists every achievement in the order the gallery shows them
+var Achievements = []Achievement{
+    {
+        ID: "first_blood", Name: "First Blood", Description: "Destroy your first atom",
+        Event: EventEnemyKilled, Goal: 1,
+    },
+    {
+        ID: "atom_smasher", Name: "Atom Smasher", Description: "Destroy 500 atoms",
+        Event: EventEnemyKilled, Goal: 500,
+    },
+    {
+        ID: "critical_mass", Name: "Critical Mass", Description: "Trigger a 5-deep chain reaction",
+        Event: EventChainReaction, Match: ValueAtLeast, Value: 5, Goal: 1,
+    },
+    {
+        ID: "no_one_left_behind", Name: "No One Left Behind", Description: "Rescue every scientist on a level",
+        Event: EventLevelCompleted, Condition: NoScientistLost, Goal: 1,
+    },
+    {
+        ID: "lifeguard", Name: "Lifeguard", Description: "Rescue 100 scientists",
+        Event: EventScientistRescued, Goal: 100,
+    },
+    {
+        ID: "flawless", Name: "Flawless", Description: "Complete a level without taking damage",
+        Event: EventLevelCompleted, Condition: NoDamageTaken, Goal: 1,
+    },
+    {
+        ID: "untouchable", Name: "Untouchable", Description: "Defeat the boss without taking damage",
+        Event: EventEnemyKilled, Match: ValueEquals, Value: int(components.Boss), Condition: NoDamageTaken, Goal: 1,
+    },
+    {
+        ID: "collector", Name: "Collector", Description: "Collect 50 power-ups",
+        Event: EventPowerUpCollected, Goal: 50,
+    },
+    {
+        ID: "meltdown_averted", Name: "Meltdown Averted", Description: "Clear the final level",
+        Event: EventLevelCompleted, Match: ValueAtLeast, Value: constants.MaxLevel, Goal: 1,
+    },
+}
+
+// matches reports whether an event's value counts toward the achievement
+func (a Achievement) matches(e Event) bool {
+    switch a.Match {
+    case ValueEquals:
+        return e.Value == a.Value
+    case ValueAtLeast:
+        return e.Value >= a.Value
+    default:
+        return true
+    }
+}
@@ -0,0 +1,221 @@
+// systems/atom_ai_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/util"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AtomAISystem steers atoms according to their prefab behavior and spins them
+type AtomAISystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    velocityID    components.ComponentID
+    enemyID       components.ComponentID
+    spriteID      components.ComponentID
+    playerID      components.ComponentID
+    rng           *util.RNG
+}
+
+// NewAtomAISystem creates a new atom AI system
+func NewAtomAISystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry, rng *util.RNG) *AtomAISystem {
+    positionID, _ := registry.GetID("Position")
+    velocityID, _ := registry.GetID("Velocity")
+    enemyID, _ := registry.GetID("Enemy")
+    spriteID, _ := registry.GetID("Sprite")
+    playerID, _ := registry.GetID("Player")
+
+    return &AtomAISystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        velocityID:    velocityID,
+        enemyID:       enemyID,
+        spriteID:      spriteID,
+        playerID:      playerID,
+        rng:           rng,
+    }
+}
+
+// Update spins every atom and adjusts its velocity based on its behavior
+func (s *AtomAISystem) Update(dt float32) {
+    // Find the player
+    var playerPos rl.Vector2
+    hasPlayer := false
+
+    playerEntities := s.entityManager.GetEntitiesWithComponents(s.playerID, s.positionID)
+    if len(playerEntities) > 0 {
+        posComp, _ := s.entityManager.GetComponent(playerEntities[0], s.positionID)
+        playerPos = posComp.(*components.Position).Value
+        hasPlayer = true
+    }
+
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.enemyID, s.positionID, s.velocityID) {
+        enemyComp, _ := s.entityManager.GetComponent(entityID, s.enemyID)
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        velComp, _ := s.entityManager.GetComponent(entityID, s.velocityID)
+
+        enemy := enemyComp.(*components.Enemy)
+        position := posComp.(*components.Position)
+        velocity := velComp.(*components.Velocity)
+        prefab := components.GetAtomPrefab(enemy.Type)
+
+        // Enemies like the boss are moved by their own system
+        if prefab.Behavior == components.BehaviorNone {
+            continue
+        }
+
+        s.updateRotation(entityID, enemy, dt)
+        s.updateSpawnTimer(entityID, enemy, prefab, dt)
+
+        // Fission fragments fly apart freely until their spawn invulnerability ends
+        if enemy.IsSpawning() {
+            continue
+        }
+
+        // Distance to the player decides whether homing and orbiting atoms engage
+        engaged := false
+        var toPlayer rl.Vector2
+        var dist float32
+        if hasPlayer {
+            toPlayer = rl.Vector2Subtract(playerPos, position.Value)
+            dist = rl.Vector2Length(toPlayer)
+            engaged = dist < prefab.EngageRadius && dist > 0
+        }
+
+        // Fleeing overrides the atom's normal behavior while the player is too close
+        if hasPlayer && dist > 0 && dist < prefab.FleeRadius {
+            s.updateFlee(enemy, velocity, toPlayer, dist, dt)
+            continue
+        }
+
+        switch prefab.Behavior {
+        case components.BehaviorDrift:
+            s.updateDrift(enemy, velocity, prefab, dt)
+        case components.BehaviorHoming:
+            if engaged {
+                s.updateHoming(enemy, velocity, prefab, toPlayer, dist, dt)
+            }
+        case components.BehaviorOrbit:
+            if engaged {
+                s.updateOrbit(entityID, enemy, velocity, prefab, toPlayer, dist, dt)
+            }
+        }
+    }
+}
+
+// updateRotation spins the atom and keeps its sprite in sync
+func (s *AtomAISystem) updateRotation(entityID components.EntityID, enemy *components.Enemy, dt float32) {
+    enemy.Rotation += enemy.SpinSpeed * dt
+    if enemy.Rotation > 2*math.Pi {
+        enemy.Rotation -= 2 * math.Pi
+    }
+
+    if spriteComp, has := s.entityManager.GetComponent(entityID, s.spriteID); has {
+        spriteComp.(*components.Sprite).Rotation = enemy.Rotation * rl.Rad2deg
+    }
+}
+
+// updateSpawnTimer counts down a fragment's spawn invulnerability, flickering its sprite meanwhile
+func (s *AtomAISystem) updateSpawnTimer(entityID components.EntityID, enemy *components.Enemy, prefab components.AtomPrefab, dt float32) {
+    if !enemy.IsSpawning() {
+        return
+    }
+
+    enemy.SpawnTimer -= dt
+
+    if spriteComp, has := s.entityManager.GetComponent(entityID, s.spriteID); has {
+        sprite := spriteComp.(*components.Sprite)
+        sprite.Tint = prefab.Tint
+        if enemy.IsSpawning() && int(enemy.SpawnTimer*20)%2 == 0 {
+            sprite.Tint = rl.Fade(prefab.Tint, 0.4)
+        }
+    }
+}
+
+// updateDrift turns the heading a little at random and keeps the atom at its slow speed
+func (s *AtomAISystem) updateDrift(enemy *components.Enemy, velocity *components.Velocity, prefab components.AtomPrefab, dt float32) {
+    if rl.Vector2Length(velocity.Value) == 0 {
+        velocity.Value = rl.Vector2{X: enemy.Speed, Y: 0}
+    }
+
+    turn := s.rng.Range(-1, 1) * prefab.WanderRate * dt
+    velocity.Value = rl.Vector2Rotate(velocity.Value, turn)
+    velocity.Value = rl.Vector2Scale(rl.Vector2Normalize(velocity.Value), enemy.Speed)
+}
+
+// updateHoming accelerates the atom toward the player without exceeding its speed cap
+func (s *AtomAISystem) updateHoming(
+    enemy *components.Enemy,
+    velocity *components.Velocity,
+    prefab components.AtomPrefab,
+    toPlayer rl.Vector2,
+    dist float32,
+    dt float32,
+) {
+    dir := rl.Vector2Scale(toPlayer, 1/dist)
+    velocity.Value = rl.Vector2Add(velocity.Value, rl.Vector2Scale(dir, prefab.HomingStrength*dt))
+
+    s.limitSpeed(velocity, enemy.Speed*prefab.MaxSpeedFactor)
+}
+
+// updateOrbit steers the atom onto a circle around the player. Atoms alternate
+// direction by entity ID so a group doesn't all circle the same way.
+func (s *AtomAISystem) updateOrbit(
+    entityID components.EntityID,
+    enemy *components.Enemy,
+    velocity *components.Velocity,
+    prefab components.AtomPrefab,
+    toPlayer rl.Vector2,
+    dist float32,
+    dt float32,
+) {
+    radial := rl.Vector2Scale(toPlayer, 1/dist)
+    tangent := rl.Vector2{X: -radial.Y, Y: radial.X}
+    if entityID%2 == 0 {
+        tangent = rl.Vector2Negate(tangent)
+    }
+
+    // Pull in or push out toward the orbit radius while moving around the player
+    radialSpeed := (dist - prefab.OrbitRadius) * 2
+    desired := rl.Vector2Add(rl.Vector2Scale(tangent, prefab.OrbitSpeed), rl.Vector2Scale(radial, radialSpeed))
+
+    blend := 1 - float32(math.Exp(float64(-3*dt)))
+    velocity.Value = rl.Vector2Lerp(velocity.Value, desired, blend)
+
+    s.limitSpeed(velocity, enemy.Speed)
+}
+
+// updateFlee turns the atom away from the player, never faster than its normal speed
+func (s *AtomAISystem) updateFlee(
+    enemy *components.Enemy,
+    velocity *components.Velocity,
+    toPlayer rl.Vector2,
+    dist float32,
+    dt float32,
+) {
+    away := rl.Vector2Scale(toPlayer, -enemy.Speed/dist)
+
+    blend := 1 - float32(math.Exp(float64(-4*dt)))
+    velocity.Value = rl.Vector2Lerp(velocity.Value, away, blend)
+
+    s.limitSpeed(velocity, enemy.Speed)
+}
+
+// limitSpeed scales the velocity down if it is faster than maxSpeed
+func (s *AtomAISystem) limitSpeed(velocity *components.Velocity, maxSpeed float32) {
+    if speed := rl.Vector2Length(velocity.Value); speed > maxSpeed {
+        velocity.Value = rl.Vector2Scale(velocity.Value, maxSpeed/speed)
+    }
+}
+
+// Draw is empty for AtomAISystem; atoms are drawn by the RenderSystem
+func (s *AtomAISystem) Draw() {
+    // Atom AI system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *AtomAISystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.enemyID, s.positionID, s.velocityID}
+}
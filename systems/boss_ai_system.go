@@ -0,0 +1,349 @@
+// systems/boss_ai_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// BossAISystem runs the boss's attack state machine: circling the arena, telegraphed
+// dashes at the player and firing volleys, getting more aggressive as its health drops
+type BossAISystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    velocityID    components.ComponentID
+    healthID      components.ComponentID
+    bossAIID      components.ComponentID
+    spriteID      components.ComponentID
+    playerID      components.ComponentID
+    factionID     components.ComponentID
+    factory       *components.EntityFactory
+}
+
+// NewBossAISystem creates a new boss AI system
+func NewBossAISystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    factory *components.EntityFactory,
+) *BossAISystem {
+    positionID, _ := registry.GetID("Position")
+    velocityID, _ := registry.GetID("Velocity")
+    healthID, _ := registry.GetID("Health")
+    bossAIID, _ := registry.GetID("BossAI")
+    spriteID, _ := registry.GetID("Sprite")
+    playerID, _ := registry.GetID("Player")
+    factionID, _ := registry.GetID("Faction")
+
+    return &BossAISystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        velocityID:    velocityID,
+        healthID:      healthID,
+        bossAIID:      bossAIID,
+        spriteID:      spriteID,
+        playerID:      playerID,
+        factionID:     factionID,
+        factory:       factory,
+    }
+}
+
+// Update advances every boss through its current phase
+func (s *BossAISystem) Update(dt float32) {
+    // Find the player; without one the boss just keeps circling
+    playerPos := rl.Vector2{X: 0, Y: 0}
+    hasPlayer := false
+
+    playerEntities := s.entityManager.GetEntitiesWithComponents(s.playerID, s.positionID)
+    if len(playerEntities) > 0 {
+        posComp, _ := s.entityManager.GetComponent(playerEntities[0], s.positionID)
+        playerPos = posComp.(*components.Position).Value
+        hasPlayer = true
+    }
+
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.bossAIID, s.positionID, s.velocityID, s.healthID) {
+        bossComp, _ := s.entityManager.GetComponent(entityID, s.bossAIID)
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        velComp, _ := s.entityManager.GetComponent(entityID, s.velocityID)
+        healthComp, _ := s.entityManager.GetComponent(entityID, s.healthID)
+
+        boss := bossComp.(*components.BossAI)
+        position := posComp.(*components.Position)
+        velocity := velComp.(*components.Velocity)
+        health := healthComp.(*components.Health)
+
+        if health.Current <= 0 {
+            velocity.Value = rl.Vector2{X: 0, Y: 0}
+            continue
+        }
+
+        s.updateTimers(boss, dt)
+        s.checkStage(boss, health)
+
+        if !hasPlayer {
+            playerPos = boss.CircleCenter
+        }
+
+        switch boss.Phase {
+        case components.BossCircling:
+            s.updateCircling(boss, position, velocity, playerPos, dt)
+        case components.BossTelegraph:
+            s.updateTelegraph(boss, velocity, playerPos, dt)
+        case components.BossDashing:
+            s.updateDashing(boss, position, velocity, dt)
+        case components.BossFiring:
+            s.updateFiring(entityID, boss, position, velocity, playerPos, dt)
+        }
+
+        s.updateTint(entityID, boss)
+    }
+}
+
+// updateTimers counts down the rotor, invulnerability and hit flash timers
+func (s *BossAISystem) updateTimers(boss *components.BossAI, dt float32) {
+    boss.RotorAngle += dt * 15.0
+    if boss.RotorAngle > 2*math.Pi {
+        boss.RotorAngle -= 2 * math.Pi
+    }
+
+    if boss.Invulnerable {
+        boss.InvTimer -= dt
+        if boss.InvTimer <= 0 {
+            boss.Invulnerable = false
+            boss.InvTimer = 0
+        }
+    }
+
+    if boss.FlashTimer > 0 {
+        boss.FlashTimer -= dt
+    }
+}
+
+// checkStage moves the boss into its next stage when health drops below a threshold
+func (s *BossAISystem) checkStage(boss *components.BossAI, health *components.Health) {
+    if boss.Stage >= len(constants.BossStageThresholds) {
+        return
+    }
+
+    healthFraction := float32(health.Current) / float32(health.Max)
+    if healthFraction > constants.BossStageThresholds[boss.Stage] {
+        return
+    }
+
+    boss.Stage++
+    boss.MakeInvulnerable(constants.BossStageInvulnerable)
+
+    // Break off the current attack and start the cycle again, faster
+    s.enterPhase(boss, components.BossCircling)
+}
+
+// stageFactor returns how much faster the boss acts in its current stage
+func (s *BossAISystem) stageFactor(boss *components.BossAI) float32 {
+    return 1.0 + 0.35*float32(boss.Stage)
+}
+
+// enterPhase switches the boss to a new phase and resets the phase timers
+func (s *BossAISystem) enterPhase(boss *components.BossAI, phase components.BossPhase) {
+    boss.Phase = phase
+
+    switch phase {
+    case components.BossCircling:
+        boss.PhaseTimer = constants.BossPhaseDuration / s.stageFactor(boss)
+    case components.BossTelegraph:
+        boss.PhaseTimer = constants.BossTelegraphTime / s.stageFactor(boss)
+    case components.BossDashing:
+        boss.PhaseTimer = constants.BossDashTime
+    case components.BossFiring:
+        boss.PhaseTimer = constants.BossPhaseDuration
+        boss.AttackTimer = 0.5 // Short delay before the first shot
+    }
+}
+
+// nextPhase picks the phase that follows the current one
+func (s *BossAISystem) nextPhase(boss *components.BossAI) {
+    switch boss.Phase {
+    case components.BossCircling:
+        boss.DashesLeft = constants.BossDashesPerPhase + boss.Stage
+        s.enterPhase(boss, components.BossTelegraph)
+        return
+    case components.BossDashing:
+        // Telegraph the next dash, or start firing when all dashes are used up
+        if boss.DashesLeft > 0 {
+            s.enterPhase(boss, components.BossTelegraph)
+            return
+        }
+        s.enterPhase(boss, components.BossFiring)
+    case components.BossFiring:
+        s.enterPhase(boss, components.BossCircling)
+    }
+
+    // Brief invulnerability when changing attack patterns
+    boss.MakeInvulnerable(constants.BossPhaseInvulnerable)
+}
+
+// updateCircling circles around a point that slowly drifts toward the player
+func (s *BossAISystem) updateCircling(
+    boss *components.BossAI,
+    position *components.Position,
+    velocity *components.Velocity,
+    playerPos rl.Vector2,
+    dt float32,
+) {
+    boss.CircleCenter = rl.Vector2Lerp(boss.CircleCenter, playerPos, 0.6*dt)
+    boss.CircleAngle += dt * s.stageFactor(boss)
+
+    target := rl.Vector2{
+        X: boss.CircleCenter.X + float32(math.Cos(float64(boss.CircleAngle)))*boss.CircleRadius,
+        Y: boss.CircleCenter.Y + float32(math.Sin(float64(boss.CircleAngle)))*boss.CircleRadius,
+    }
+
+    toTarget := rl.Vector2Subtract(target, position.Value)
+    if rl.Vector2Length(toTarget) > 1 {
+        velocity.Value = rl.Vector2Scale(rl.Vector2Normalize(toTarget), constants.BossSpeed*s.stageFactor(boss))
+    } else {
+        velocity.Value = rl.Vector2{X: 0, Y: 0}
+    }
+
+    boss.PhaseTimer -= dt
+    if boss.PhaseTimer <= 0 {
+        s.nextPhase(boss)
+    }
+}
+
+// updateTelegraph hovers in place while the dash target follows the player,
+// then locks the target in so the player has time to get out of the way
+func (s *BossAISystem) updateTelegraph(boss *components.BossAI, velocity *components.Velocity, playerPos rl.Vector2, dt float32) {
+    velocity.Value = rl.Vector2Scale(velocity.Value, 0.85)
+
+    telegraphTime := constants.BossTelegraphTime / s.stageFactor(boss)
+    if boss.PhaseTimer > telegraphTime*0.4 {
+        boss.DashTarget = playerPos
+    }
+
+    boss.PhaseTimer -= dt
+    if boss.PhaseTimer <= 0 {
+        boss.DashesLeft--
+        s.enterPhase(boss, components.BossDashing)
+    }
+}
+
+// updateDashing flies quickly toward the telegraphed target, then slows down
+func (s *BossAISystem) updateDashing(
+    boss *components.BossAI,
+    position *components.Position,
+    velocity *components.Velocity,
+    dt float32,
+) {
+    // Lock in the direction on the first frame of the dash
+    if boss.PhaseTimer >= constants.BossDashTime {
+        dir := rl.Vector2Subtract(boss.DashTarget, position.Value)
+        if rl.Vector2Length(dir) > 0 {
+            dir = rl.Vector2Normalize(dir)
+        }
+        velocity.Value = rl.Vector2Scale(dir, constants.BossSpeed*constants.BossDashSpeedFactor*s.stageFactor(boss))
+    } else if boss.PhaseTimer < constants.BossDashTime*0.3 {
+        // Slow down at the end of the dash
+        velocity.Value = rl.Vector2Scale(velocity.Value, 0.9)
+    }
+
+    boss.PhaseTimer -= dt
+    if boss.PhaseTimer <= 0 {
+        s.nextPhase(boss)
+    }
+}
+
+// updateFiring drifts toward the player and fires volleys that widen with each stage
+func (s *BossAISystem) updateFiring(
+    entityID components.EntityID,
+    boss *components.BossAI,
+    position *components.Position,
+    velocity *components.Velocity,
+    playerPos rl.Vector2,
+    dt float32,
+) {
+    velocity.Value = rl.Vector2Scale(velocity.Value, 0.98)
+
+    boss.AttackTimer -= dt
+    if boss.AttackTimer <= 0 {
+        boss.AttackTimer = constants.BossFireInterval / s.stageFactor(boss)
+
+        dir := rl.Vector2Subtract(playerPos, position.Value)
+        if rl.Vector2Length(dir) == 0 {
+            dir = rl.Vector2{X: 0, Y: 1}
+        }
+        dir = rl.Vector2Normalize(dir)
+
+        // Move slightly while firing to avoid being a sitting duck
+        velocity.Value = rl.Vector2Scale(dir, constants.BossSpeed*0.5)
+
+        s.fireVolley(entityID, boss, position.Value, dir)
+    }
+
+    boss.PhaseTimer -= dt
+    if boss.PhaseTimer <= 0 {
+        s.nextPhase(boss)
+    }
+}
+
+// fireVolley fires one aimed shot in the first stage, a spread of three in the
+// second and adds a ring of shots around the boss in the last stage
+func (s *BossAISystem) fireVolley(entityID components.EntityID, boss *components.BossAI, origin rl.Vector2, dir rl.Vector2) {
+    baseAngle := float32(math.Atan2(float64(dir.Y), float64(dir.X)))
+
+    spread := boss.Stage
+    if spread > 1 {
+        spread = 1
+    }
+    for i := -spread; i <= spread; i++ {
+        s.spawnProjectile(entityID, origin, baseAngle+float32(i)*0.25)
+    }
+
+    if boss.Stage >= 2 {
+        ringShots := 8
+        for i := 0; i < ringShots; i++ {
+            s.spawnProjectile(entityID, origin, baseAngle+float32(i)*2*math.Pi/float32(ringShots)+math.Pi/float32(ringShots))
+        }
+    }
+}
+
+// spawnProjectile fires an enemy bullet from the boss at the given angle
+func (s *BossAISystem) spawnProjectile(owner components.EntityID, origin rl.Vector2, angle float32) {
+    vel := rl.Vector2{
+        X: float32(math.Cos(float64(angle))) * constants.EnemyBulletSpeed,
+        Y: float32(math.Sin(float64(angle))) * constants.EnemyBulletSpeed,
+    }
+
+    bulletID := s.factory.CreateBullet(origin.X, origin.Y, vel.X, vel.Y, true)
+
+    // Remember who fired it
+    if factionComp, has := s.entityManager.GetComponent(bulletID, s.factionID); has {
+        factionComp.(*components.Faction).Owner = owner
+    }
+}
+
+// updateTint flashes the boss sprite while it is invulnerable or has just been hit
+func (s *BossAISystem) updateTint(entityID components.EntityID, boss *components.BossAI) {
+    spriteComp, has := s.entityManager.GetComponent(entityID, s.spriteID)
+    if !has {
+        return
+    }
+    sprite := spriteComp.(*components.Sprite)
+
+    sprite.Tint = rl.Red
+    if boss.FlashTimer > 0 {
+        sprite.Tint = rl.White
+    } else if boss.Invulnerable && int(boss.InvTimer*10)%2 == 0 {
+        sprite.Tint = rl.Color{R: 139, G: 0, B: 0, A: 255} // Dark red
+    }
+}
+
+// Draw is empty for BossAISystem; the boss is drawn by the RenderSystem
+func (s *BossAISystem) Draw() {
+    // Boss AI system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *BossAISystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.bossAIID, s.positionID, s.velocityID, s.healthID}
+}
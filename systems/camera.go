@@ -0,0 +1,189 @@
+// systems/camera.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Camera follows the player around a world that can be larger than the screen
+type Camera struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    playerID      components.ComponentID
+    worldBounds   *rl.Rectangle // Pointer to the world bounds in the game state
+    Camera2D      rl.Camera2D
+    DeadZone      rl.Vector2 // Width and height of the dead zone in screen pixels
+    Smoothing     float32
+    targetZoom    float32
+}
+
+// NewCamera creates a new camera centered on the screen
+func NewCamera(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry, worldBounds *rl.Rectangle) *Camera {
+    positionID, _ := registry.GetID("Position")
+    playerID, _ := registry.GetID("Player")
+
+    return &Camera{
+        entityManager: entityManager,
+        positionID:    positionID,
+        playerID:      playerID,
+        worldBounds:   worldBounds,
+        Camera2D: rl.Camera2D{
+            Offset:   rl.Vector2{X: constants.ScreenWidth / 2, Y: constants.ScreenHeight / 2},
+            Target:   rl.Vector2{X: constants.ScreenWidth / 2, Y: constants.ScreenHeight / 2},
+            Rotation: 0,
+            Zoom:     1.0,
+        },
+        DeadZone:   rl.Vector2{X: constants.CameraDeadZoneWidth, Y: constants.CameraDeadZoneHeight},
+        Smoothing:  constants.CameraSmoothing,
+        targetZoom: 1.0,
+    }
+}
+
+// Update moves the camera toward the player and keeps it inside the world
+func (c *Camera) Update(dt float32) {
+    // Ease zoom toward the requested level
+    blend := 1 - float32(math.Exp(float64(-c.Smoothing*dt)))
+    c.Camera2D.Zoom += (c.targetZoom - c.Camera2D.Zoom) * blend
+
+    playerPos, found := c.findPlayer()
+    if !found {
+        c.clampToWorld()
+        return
+    }
+
+    // Only move the camera once the player leaves the dead zone. The dead zone
+    // is defined in screen pixels, so convert it to world units for the current zoom.
+    halfDeadX := c.DeadZone.X / 2 / c.Camera2D.Zoom
+    halfDeadY := c.DeadZone.Y / 2 / c.Camera2D.Zoom
+
+    desired := c.Camera2D.Target
+    if playerPos.X < desired.X-halfDeadX {
+        desired.X = playerPos.X + halfDeadX
+    } else if playerPos.X > desired.X+halfDeadX {
+        desired.X = playerPos.X - halfDeadX
+    }
+
+    if playerPos.Y < desired.Y-halfDeadY {
+        desired.Y = playerPos.Y + halfDeadY
+    } else if playerPos.Y > desired.Y+halfDeadY {
+        desired.Y = playerPos.Y - halfDeadY
+    }
+
+    // Smoothly move toward the desired target (frame-rate independent)
+    c.Camera2D.Target.X += (desired.X - c.Camera2D.Target.X) * blend
+    c.Camera2D.Target.Y += (desired.Y - c.Camera2D.Target.Y) * blend
+
+    c.clampToWorld()
+}
+
+// Snap centers the camera on the player immediately, skipping smoothing
+func (c *Camera) Snap() {
+    c.Camera2D.Zoom = c.targetZoom
+
+    if playerPos, found := c.findPlayer(); found {
+        c.Camera2D.Target = playerPos
+    }
+
+    c.clampToWorld()
+}
+
+// SetZoom sets the zoom level the camera eases toward
+func (c *Camera) SetZoom(zoom float32) {
+    if zoom < constants.CameraMinZoom {
+        zoom = constants.CameraMinZoom
+    }
+    if zoom > constants.CameraMaxZoom {
+        zoom = constants.CameraMaxZoom
+    }
+    c.targetZoom = zoom
+}
+
+// AdjustZoom changes the zoom level by the given amount
+func (c *Camera) AdjustZoom(amount float32) {
+    c.SetZoom(c.targetZoom + amount)
+}
+
+// Begin starts drawing in world space; everything drawn until End is transformed by the camera
+func (c *Camera) Begin() {
+    rl.BeginMode2D(c.Camera2D)
+}
+
+// End returns to screen-space drawing
+func (c *Camera) End() {
+    rl.EndMode2D()
+}
+
+// ScreenToWorld converts a screen position (such as the mouse) into world coordinates
+func (c *Camera) ScreenToWorld(screenPos rl.Vector2) rl.Vector2 {
+    return rl.GetScreenToWorld2D(screenPos, c.Camera2D)
+}
+
+// WorldToScreen converts a world position into screen coordinates
+func (c *Camera) WorldToScreen(worldPos rl.Vector2) rl.Vector2 {
+    return rl.GetWorldToScreen2D(worldPos, c.Camera2D)
+}
+
+// VisibleBounds returns the part of the world currently visible on screen
+func (c *Camera) VisibleBounds() rl.Rectangle {
+    halfW := constants.ScreenWidth / 2 / c.Camera2D.Zoom
+    halfH := constants.ScreenHeight / 2 / c.Camera2D.Zoom
+
+    return rl.Rectangle{
+        X:      c.Camera2D.Target.X - halfW,
+        Y:      c.Camera2D.Target.Y - halfH,
+        Width:  halfW * 2,
+        Height: halfH * 2,
+    }
+}
+
+// findPlayer returns the player's position if a player exists
+func (c *Camera) findPlayer() (rl.Vector2, bool) {
+    playerEntities := c.entityManager.GetEntitiesWithComponents(c.playerID, c.positionID)
+    if len(playerEntities) == 0 {
+        return rl.Vector2{}, false
+    }
+
+    posComp, _ := c.entityManager.GetComponent(playerEntities[0], c.positionID)
+    return posComp.(*components.Position).Value, true
+}
+
+// clampToWorld keeps the visible area inside the world bounds, centering the
+// camera on any axis where the world is smaller than the screen
+func (c *Camera) clampToWorld() {
+    if c.worldBounds == nil {
+        return
+    }
+
+    bounds := *c.worldBounds
+    halfW := constants.ScreenWidth / 2 / c.Camera2D.Zoom
+    halfH := constants.ScreenHeight / 2 / c.Camera2D.Zoom
+
+    if bounds.Width <= halfW*2 {
+        c.Camera2D.Target.X = bounds.X + bounds.Width/2
+    } else if c.Camera2D.Target.X < bounds.X+halfW {
+        c.Camera2D.Target.X = bounds.X + halfW
+    } else if c.Camera2D.Target.X > bounds.X+bounds.Width-halfW {
+        c.Camera2D.Target.X = bounds.X + bounds.Width - halfW
+    }
+
+    if bounds.Height <= halfH*2 {
+        c.Camera2D.Target.Y = bounds.Y + bounds.Height/2
+    } else if c.Camera2D.Target.Y < bounds.Y+halfH {
+        c.Camera2D.Target.Y = bounds.Y + halfH
+    } else if c.Camera2D.Target.Y > bounds.Y+bounds.Height-halfH {
+        c.Camera2D.Target.Y = bounds.Y + bounds.Height - halfH
+    }
+}
+
+// Draw is empty for Camera as it doesn't render anything itself
+func (c *Camera) Draw() {
+    // Camera doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (c *Camera) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{c.playerID, c.positionID}
+}
@@ -4,6 +4,8 @@ package systems
 import (
     "atomblaster/components"
     "atomblaster/constants"
+    "atomblaster/util"
+    "math"
     rl "github.com/gen2brain/raylib-go/raylib"
 )
 
@@ -15,26 +17,79 @@ type CollisionSystem struct {
     tagID         components.ComponentID
     healthID      components.ComponentID
     playerID      components.ComponentID
-    scoreValue    *int // Pointer to the score value in the game state
+    factionID     components.ComponentID
+    enemyID       components.ComponentID
+    velocityID    components.ComponentID
+    cascadeID     components.ComponentID
+    electronID    components.ComponentID
+    projectileID  components.ComponentID
+    weaponID      components.ComponentID
+    effectsID     components.ComponentID
+    events        *EventBus
+    factory       *components.EntityFactory // Used to spawn fission fragments
+    rng           *util.RNG
+    difficulty    *DifficultyProfile        // Scales damage dealt to the player and invulnerability after hits
 }
 
+// killCause is how an atom was destroyed, which decides whether it scores and splits
+type killCause int
+
+const (
+    killedByShot      killCause = iota // Scores, splits and carries on the shot's chain reaction
+    killedByRam                        // The player flew into it: no points and no fragments
+    killedByRadiation                  // Caught in a decay pulse: splits but scores nothing
+)
+
 // NewCollisionSystem creates a new collision system
-func NewCollisionSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry, score *int) *CollisionSystem {
+func NewCollisionSystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    events *EventBus,
+    factory *components.EntityFactory,
+    rng *util.RNG,
+    difficulty *DifficultyProfile,
+) *CollisionSystem {
     positionID, _ := registry.GetID("Position")
     colliderID, _ := registry.GetID("Collider")
     tagID, _ := registry.GetID("Tag")
     healthID, _ := registry.GetID("Health")
     playerID, _ := registry.GetID("Player")
+    factionID, _ := registry.GetID("Faction")
+    enemyID, _ := registry.GetID("Enemy")
+    velocityID, _ := registry.GetID("Velocity")
+    cascadeID, _ := registry.GetID("Cascade")
+    electronID, _ := registry.GetID("Electron")
+    projectileID, _ := registry.GetID("Projectile")
+    weaponID, _ := registry.GetID("Weapon")
+    effectsID, _ := registry.GetID("ActiveEffects")
     
-    return &CollisionSystem{
+    s := &CollisionSystem{
         entityManager: entityManager,
         positionID:    positionID,
         colliderID:    colliderID,
         tagID:         tagID,
         healthID:      healthID,
         playerID:      playerID,
-        scoreValue:    score,
+        factionID:     factionID,
+        enemyID:       enemyID,
+        velocityID:    velocityID,
+        cascadeID:     cascadeID,
+        electronID:    electronID,
+        projectileID:  projectileID,
+        weaponID:      weaponID,
+        effectsID:     effectsID,
+        events:        events,
+        factory:       factory,
+        rng:           rng,
+        difficulty:    difficulty,
     }
+    
+    // Radiation pulses from decaying atoms hurt whatever is in reach
+    if events != nil {
+        events.Subscribe(EventRadiationPulse, s.handleRadiationPulse)
+    }
+    
+    return s
 }
 
 // Update checks for and handles collisions between entities
@@ -64,6 +119,9 @@ func (s *CollisionSystem) Update(dt float32) {
     // Process bullet collisions with enemies
     s.handleBulletCollisions(entities)
     
+    // Process atoms running into each other
+    s.handleAtomCollisions(entities)
+    
     // Process other special collisions (scientists, rescue zone, etc.)
     s.handleSpecialCollisions(entities)
 }
@@ -112,20 +170,33 @@ func (s *CollisionSystem) handlePlayerCollisions(
             // Check for collision between player and enemy
             if s.checkCollision(playerPos.Value, playerCollider, position.Value, collider) {
                 // Player hit by enemy
-                if playerHealth != nil && !player.IsDashing {
-                    playerHealth.TakeDamage(1)
+                if playerHealth != nil && !player.IsInvulnerable() && !s.isShielded(playerEntity) {
+                    damage := s.difficulty.ScaleDamage(1)
+                    playerHealth.TakeDamage(damage)
+                    s.events.Publish(Event{Type: EventPlayerDamaged, Entity: playerEntity, Position: playerPos.Value, Value: damage})
                     
                     // Spawn particle effect
                     s.spawnCollisionParticles(playerPos.Value, 30, rl.Red, 3.0)
                     
                     // Play sound (handled separately)
                     
-                    // Remove atom or make player briefly invincible
-                    s.entityManager.DestroyEntity(entityID)
+                    // Remove atom (without splitting it) and make player briefly invincible
+                    s.killEnemy(entityID, position.Value, nil, killedByRam)
+                    
+                    player.MakeInvulnerable(constants.HitInvulnerableTime * s.difficulty.Invulnerability)
+                }
+            }
+            
+        case components.BossTag:
+            // Flying into the boss (or being dashed into) hurts, but the boss stays
+            if s.checkCollision(playerPos.Value, playerCollider, position.Value, collider) {
+                if playerHealth != nil && !player.IsInvulnerable() && !s.isShielded(playerEntity) {
+                    damage := s.difficulty.ScaleDamage(1)
+                    playerHealth.TakeDamage(damage)
+                    s.events.Publish(Event{Type: EventPlayerDamaged, Entity: playerEntity, Position: playerPos.Value, Value: damage})
+                    s.spawnCollisionParticles(playerPos.Value, 30, rl.Red, 3.0)
                     
-                    // Activate dash for brief invincibility
-                    player.IsDashing = true
-                    player.DashTimer = 0.2
+                    player.MakeInvulnerable(constants.BossHitInvulnerableTime * s.difficulty.Invulnerability)
                 }
             }
             
@@ -138,24 +209,30 @@ func (s *CollisionSystem) handlePlayerCollisions(
                     powerUp := powerUpComp.(*components.PowerUp)
                     
                     switch powerUp.Type {
-                    case components.PowerUpGun:
-                        player.HasGun = true
-                        *s.scoreValue += 25
+                    case components.PowerUpWeapon:
+                        if weaponComp, has := s.entityManager.GetComponent(playerEntity, s.weaponID); has {
+                            weaponComp.(*components.Weapon).Grant(powerUp.Weapon)
+                        }
                         s.spawnCollisionParticles(position.Value, 15, rl.Orange, 3.0)
                         
                     case components.PowerUpHealth:
                         if playerHealth != nil {
                             playerHealth.Heal(1)
-                            *s.scoreValue += 15
                             s.spawnCollisionParticles(position.Value, 15, rl.Green, 3.0)
                         }
                         
-                    case components.PowerUpSpeed:
-                        player.Speed += 50
-                        *s.scoreValue += 20
-                        s.spawnCollisionParticles(position.Value, 15, rl.Purple, 3.0)
+                    default:
+                        // Everything else is a timed effect
+                        if effectType, timed := powerUp.Type.Effect(); timed {
+                            if effectsComp, has := s.entityManager.GetComponent(playerEntity, s.effectsID); has {
+                                effectsComp.(*components.ActiveEffects).Apply(effectType, powerUp.Duration)
+                            }
+                            s.spawnCollisionParticles(position.Value, 15, components.EffectDefs[effectType].Color, 3.0)
+                        }
                     }
                     
+                    s.events.Publish(Event{Type: EventPowerUpCollected, Entity: entityID, Position: position.Value, Value: int(powerUp.Type)})
+                    
                     // Remove power-up
                     s.entityManager.DestroyEntity(entityID)
                 }
@@ -164,8 +241,14 @@ func (s *CollisionSystem) handlePlayerCollisions(
         case components.DoorTag:
             // Check if player is at the door and conditions are met to advance level
             if s.checkCollision(playerPos.Value, playerCollider, position.Value, collider) {
-                // Level completion logic would go here
-                // This will be handled by a separate GameStateSystem
+                // Flag the door; the LevelSystem handles the level transition
+                doorID, _ := s.entityManager.GetEntityManager().Registry.GetID("Door")
+                if doorComp, has := s.entityManager.GetComponent(entityID, doorID); has {
+                    door := doorComp.(*components.Door)
+                    if door.Unlocked {
+                        door.PlayerReached = true
+                    }
+                }
             }
         }
     }
@@ -192,6 +275,31 @@ func (s *CollisionSystem) handleBulletCollisions(entities []components.EntityID)
         bulletPos := posComp.(*components.Position)
         bulletCollider := collComp.(*components.Collider)
         
+        // Bullets without a faction are treated as the player's
+        faction := components.PlayerFaction
+        if factionComp, has := s.entityManager.GetComponent(bulletID, s.factionID); has {
+            faction = factionComp.(*components.Faction).Type
+        }
+        
+        // Chain reaction the bullet belongs to, if any
+        var cascade *components.Cascade
+        if cascadeComp, has := s.entityManager.GetComponent(bulletID, s.cascadeID); has {
+            cascade = cascadeComp.(*components.Cascade)
+        }
+        
+        // Neutrons only wear atoms down
+        damage := 10
+        if faction == components.NeutronFaction {
+            damage = constants.NeutronDamage
+        }
+        
+        // Projectiles carry their own damage and may pierce several targets
+        var projectile *components.Projectile
+        if projectileComp, has := s.entityManager.GetComponent(bulletID, s.projectileID); has {
+            projectile = projectileComp.(*components.Projectile)
+            damage = projectile.Damage
+        }
+        
         // Check against all potential targets
         for _, targetID := range entities {
             // Skip self
@@ -199,6 +307,11 @@ func (s *CollisionSystem) handleBulletCollisions(entities []components.EntityID)
                 continue
             }
             
+            // Piercing shots hit each target only once
+            if projectile != nil && projectile.HasHit(targetID) {
+                continue
+            }
+            
             // Get target components
             tagComp, hasTag := s.entityManager.GetComponent(targetID, s.tagID)
             if !hasTag {
@@ -207,8 +320,18 @@ func (s *CollisionSystem) handleBulletCollisions(entities []components.EntityID)
             
             tag := tagComp.(*components.Tag)
             
-            // Only check collision with enemies
-            if tag.Type != components.EnemyTag && tag.Type != components.BossTag {
+            // Only check collision with targets the bullet's faction can hurt
+            if !faction.CanDamage(tag.Type) {
+                continue
+            }
+            
+            // Freshly split fragments can't be hit yet
+            if enemyComp, has := s.entityManager.GetComponent(targetID, s.enemyID); has && enemyComp.(*components.Enemy).IsSpawning() {
+                continue
+            }
+            
+            // Knocked off electrons don't block shots until they regenerate
+            if electronComp, has := s.entityManager.GetComponent(targetID, s.electronID); has && !electronComp.(*components.Electron).Active {
                 continue
             }
             
@@ -221,42 +344,82 @@ func (s *CollisionSystem) handleBulletCollisions(entities []components.EntityID)
             if s.checkCollision(bulletPos.Value, bulletCollider, targetPos.Value, targetCollider) {
                 // Hit detected!
                 
+                // Enemy shots hurt the player and scientists
+                if tag.Type == components.PlayerTag {
+                    if !s.hitPlayer(targetID, targetPos.Value, 1) {
+                        continue // Invulnerable players let enemy shots pass through
+                    }
+                    s.entityManager.DestroyEntity(bulletID)
+                    break
+                }
+                if tag.Type == components.ScientistTag {
+                    s.hitScientist(targetID, targetPos.Value)
+                    s.entityManager.DestroyEntity(bulletID)
+                    break
+                }
+                
+                // A player shot counts as a hit for accuracy on the first target it hits
+                if faction == components.PlayerFaction && projectile != nil && len(projectile.Hit) == 0 {
+                    s.events.Publish(Event{Type: EventShotHit, Entity: bulletID, Position: bulletPos.Value})
+                }
+                
+                // Electron shells absorb the hit, whether the shot hits the electron or the nucleus
+                shellID := targetID
+                if tag.Type != components.ElectronTag {
+                    shellID = s.activeShell(targetID)
+                }
+                if shellID != 0 {
+                    s.knockOffElectron(shellID)
+                    s.entityManager.DestroyEntity(bulletID)
+                    break
+                }
+                
+                // Bosses shrug off shots while invulnerable and flash when hit
+                bossAIID, _ := s.entityManager.GetEntityManager().Registry.GetID("BossAI")
+                if bossComp, has := s.entityManager.GetComponent(targetID, bossAIID); has {
+                    boss := bossComp.(*components.BossAI)
+                    if boss.Invulnerable {
+                        s.spawnCollisionParticles(bulletPos.Value, 5, rl.LightGray, 1.0)
+                        s.entityManager.DestroyEntity(bulletID)
+                        break
+                    }
+                    boss.FlashTimer = 0.1
+                }
+                
                 // Check if enemy has health
                 if healthComp, has := s.entityManager.GetComponent(targetID, s.healthID); has {
                     health := healthComp.(*components.Health)
                     
                     // Apply damage
-                    if !health.TakeDamage(10) {
+                    if !health.TakeDamage(damage) {
                         // Enemy defeated
-                        *s.scoreValue += 10
                         
                         // Spawn particles
                         s.spawnCollisionParticles(targetPos.Value, 15, rl.Yellow, 2.0)
                         
                         // Check for boss
                         if tag.Type == components.BossTag {
-                            *s.scoreValue += 1990 // Total 2000 for boss
                             s.spawnCollisionParticles(targetPos.Value, 50, rl.Orange, 5.0)
+                            s.events.Publish(Event{Type: EventEnemyKilled, Entity: targetID, Position: targetPos.Value, Value: int(components.Boss)})
                             s.entityManager.DestroyEntity(targetID)
                         } else {
-                            // Regular enemy - possibility to spawn power-up
-                            if rl.GetRandomValue(0, 100) < 15 {
-                                s.spawnPowerUp(targetPos.Value)
-                            }
-                            
-                            // Destroy the enemy
-                            s.entityManager.DestroyEntity(targetID)
+                            // Destroy the atom and set off the next step of the chain reaction
+                            s.killEnemy(targetID, targetPos.Value, cascade, killedByShot)
                         }
                     } else {
                         // Enemy damaged but not defeated
-                        *s.scoreValue += 5
+                        s.events.Publish(Event{Type: EventEnemyHit, Entity: targetID, Position: targetPos.Value})
                         s.spawnCollisionParticles(targetPos.Value, 5, rl.Yellow, 1.0)
                     }
                 } else {
                     // Enemy has no health component - destroy immediately
-                    *s.scoreValue += 10
                     s.spawnCollisionParticles(targetPos.Value, 15, rl.Yellow, 2.0)
-                    s.entityManager.DestroyEntity(targetID)
+                    s.killEnemy(targetID, targetPos.Value, cascade, killedByShot)
+                }
+                
+                // Piercing shots keep flying until they have used up their pierce
+                if projectile != nil && projectile.RegisterHit(targetID) {
+                    continue
                 }
                 
                 // Destroy the bullet
@@ -269,6 +432,416 @@ func (s *CollisionSystem) handleBulletCollisions(entities []components.EntityID)
     }
 }
 
+// handleAtomCollisions fuses atoms that run into each other fast enough
+func (s *CollisionSystem) handleAtomCollisions(entities []components.EntityID) {
+    // Get atoms that can take part in fusion
+    atoms := []components.EntityID{}
+    for _, entityID := range entities {
+        tagComp, hasTag := s.entityManager.GetComponent(entityID, s.tagID)
+        if !hasTag || tagComp.(*components.Tag).Type != components.EnemyTag {
+            continue
+        }
+        if enemyComp, has := s.entityManager.GetComponent(entityID, s.enemyID); has && !enemyComp.(*components.Enemy).IsSpawning() {
+            atoms = append(atoms, entityID)
+        }
+    }
+    
+    fused := make(map[components.EntityID]bool)
+    
+    for i := 0; i < len(atoms); i++ {
+        for j := i + 1; j < len(atoms); j++ {
+            a, b := atoms[i], atoms[j]
+            if fused[a] || fused[b] {
+                continue
+            }
+            
+            posCompA, _ := s.entityManager.GetComponent(a, s.positionID)
+            collCompA, _ := s.entityManager.GetComponent(a, s.colliderID)
+            posCompB, _ := s.entityManager.GetComponent(b, s.positionID)
+            collCompB, _ := s.entityManager.GetComponent(b, s.colliderID)
+            
+            posA := posCompA.(*components.Position).Value
+            posB := posCompB.(*components.Position).Value
+            if !s.checkCollision(posA, collCompA.(*components.Collider), posB, collCompB.(*components.Collider)) {
+                continue
+            }
+            
+            if s.tryFuse(a, b) {
+                fused[a] = true
+                fused[b] = true
+            }
+        }
+    }
+}
+
+// tryFuse fuses two touching atoms if a fusion rule allows it and they hit hard enough.
+// The fused atom conserves the parents' momentum and combines their health.
+func (s *CollisionSystem) tryFuse(a, b components.EntityID) bool {
+    if s.factory == nil {
+        return false
+    }
+    
+    enemyCompA, _ := s.entityManager.GetComponent(a, s.enemyID)
+    enemyCompB, _ := s.entityManager.GetComponent(b, s.enemyID)
+    enemyA := enemyCompA.(*components.Enemy)
+    enemyB := enemyCompB.(*components.Enemy)
+    
+    rule, ok := components.FindFusionRule(enemyA.Type, enemyB.Type)
+    if !ok {
+        return false
+    }
+    
+    posCompA, _ := s.entityManager.GetComponent(a, s.positionID)
+    posCompB, _ := s.entityManager.GetComponent(b, s.positionID)
+    posA := posCompA.(*components.Position).Value
+    posB := posCompB.(*components.Position).Value
+    
+    var velA, velB rl.Vector2
+    if velComp, has := s.entityManager.GetComponent(a, s.velocityID); has {
+        velA = velComp.(*components.Velocity).Value
+    }
+    if velComp, has := s.entityManager.GetComponent(b, s.velocityID); has {
+        velB = velComp.(*components.Velocity).Value
+    }
+    
+    // Gentle bumps don't fuse
+    if rl.Vector2Length(rl.Vector2Subtract(velA, velB)) < rule.MinRelativeSpeed {
+        return false
+    }
+    
+    // Treat mass as proportional to the collider area
+    prefabA := components.GetAtomPrefab(enemyA.Type)
+    prefabB := components.GetAtomPrefab(enemyB.Type)
+    resultPrefab := components.GetAtomPrefab(rule.Result)
+    massA := prefabA.Radius * prefabA.Radius
+    massB := prefabB.Radius * prefabB.Radius
+    totalMass := massA + massB
+    
+    pos := rl.Vector2Scale(rl.Vector2Add(rl.Vector2Scale(posA, massA), rl.Vector2Scale(posB, massB)), 1/totalMass)
+    vel := rl.Vector2Scale(rl.Vector2Add(rl.Vector2Scale(velA, massA), rl.Vector2Scale(velB, massB)), 1/totalMass)
+    
+    // Keep the level's base speed, adjusted for how fast the new type normally is
+    baseSpeed := (enemyA.Speed/prefabA.SpeedFactor + enemyB.Speed/prefabB.SpeedFactor) / 2
+    fusedID := s.factory.CreateAtomWithSpeed(pos.X, pos.Y, vel.X, vel.Y, rule.Result, baseSpeed*resultPrefab.SpeedFactor)
+    
+    // Combined health, never less than a fresh atom of the new type
+    combinedHealth := 0
+    for _, parentID := range []components.EntityID{a, b} {
+        if healthComp, has := s.entityManager.GetComponent(parentID, s.healthID); has {
+            combinedHealth += healthComp.(*components.Health).Current
+        }
+    }
+    if healthComp, has := s.entityManager.GetComponent(fusedID, s.healthID); has {
+        health := healthComp.(*components.Health)
+        if combinedHealth > health.Max {
+            health.Max = combinedHealth
+        }
+        health.Current = combinedHealth
+        if health.Current < resultPrefab.Health {
+            health.Current = resultPrefab.Health
+        }
+    }
+    
+    // The fused atom takes its parents' place in their fission chain
+    if fusedComp, has := s.entityManager.GetComponent(fusedID, s.enemyID); has {
+        fusedEnemy := fusedComp.(*components.Enemy)
+        fusedEnemy.SpawnTimer = constants.FusionSpawnInvulnerable
+        
+        chain := enemyA.Chain
+        if chain == nil {
+            chain = enemyB.Chain
+        }
+        if chain != nil {
+            chain.Remaining++
+            fusedEnemy.Chain = chain
+        }
+    }
+    
+    // Remove the parents without awarding points or splitting them
+    s.destroyEnemy(a, posA, 0, false)
+    s.destroyEnemy(b, posB, 0, false)
+    
+    s.spawnCollisionParticles(pos, 30, rl.Purple, 3.0)
+    s.events.Publish(Event{Type: EventAtomsFused, Entity: fusedID, Position: pos, Value: int(rule.Result)})
+    return true
+}
+
+// activeShell returns an electron still shielding the given atom, or 0 if it has been stripped
+func (s *CollisionSystem) activeShell(atomID components.EntityID) components.EntityID {
+    for _, electronEntity := range s.entityManager.GetEntitiesWithComponent(s.electronID) {
+        electronComp, _ := s.entityManager.GetComponent(electronEntity, s.electronID)
+        electron := electronComp.(*components.Electron)
+        if electron.Parent == atomID && electron.Active {
+            return electronEntity
+        }
+    }
+    return 0
+}
+
+// knockOffElectron strips an electron from its atom with a burst of particles
+func (s *CollisionSystem) knockOffElectron(electronEntity components.EntityID) {
+    electronComp, has := s.entityManager.GetComponent(electronEntity, s.electronID)
+    if !has {
+        return
+    }
+    electronComp.(*components.Electron).KnockOff(constants.ElectronRegenDelay)
+    
+    if posComp, has := s.entityManager.GetComponent(electronEntity, s.positionID); has {
+        s.spawnCollisionParticles(posComp.(*components.Position).Value, 12, rl.SkyBlue, 1.5)
+    }
+}
+
+// killEnemy destroys an atom and emits a ring of neutrons from it. Every atom death goes
+// through here except fusion, where the parents live on in the fused atom. cascade is the
+// projectile that destroyed the atom; without one the atom starts a chain reaction of its own.
+// Atoms shot deeper in a chain reaction are worth more.
+func (s *CollisionSystem) killEnemy(entityID components.EntityID, pos rl.Vector2, cascade *components.Cascade, cause killCause) {
+    origin := entityID
+    depth := 0
+    if cascade != nil {
+        origin = cascade.Origin
+        depth = cascade.Depth
+    }
+    
+    points := 0
+    if cause == killedByShot {
+        multiplier := 1 + depth
+        if multiplier > ScoreValues.MaxCascadeMultiplier {
+            multiplier = ScoreValues.MaxCascadeMultiplier
+        }
+        points = ScoreValues.FissionAtom * multiplier
+        
+        // Let the loot tables know what was destroyed before the atom is gone
+        if enemyComp, has := s.entityManager.GetComponent(entityID, s.enemyID); has {
+            enemyType := enemyComp.(*components.Enemy).Type
+            s.events.Publish(Event{Type: EventEnemyKilled, Entity: entityID, Position: pos, Value: int(enemyType)})
+        }
+    }
+    
+    // Split the atom and destroy it; points are awarded once its whole fission chain is gone
+    s.destroyEnemy(entityID, pos, points, cause != killedByRam)
+    
+    if depth > 0 {
+        s.events.Publish(Event{Type: EventChainReaction, Entity: origin, Position: pos, Value: depth})
+    }
+    
+    s.emitNeutrons(pos, origin, depth+1)
+}
+
+// emitNeutrons sends a ring of neutrons out from a destroyed atom, up to the global neutron cap
+func (s *CollisionSystem) emitNeutrons(pos rl.Vector2, origin components.EntityID, depth int) {
+    if s.factory == nil {
+        return
+    }
+    
+    count := constants.NeutronsPerAtom
+    if available := constants.MaxNeutrons - s.countNeutrons(); count > available {
+        count = available
+    }
+    
+    baseAngle := float64(s.rng.Range(0, 2*math.Pi))
+    for i := 0; i < count; i++ {
+        angle := baseAngle + 2*math.Pi*float64(i)/float64(constants.NeutronsPerAtom)
+        vel := rl.Vector2{
+            X: float32(math.Cos(angle)) * constants.NeutronSpeed,
+            Y: float32(math.Sin(angle)) * constants.NeutronSpeed,
+        }
+        s.factory.CreateNeutron(pos.X, pos.Y, vel.X, vel.Y, origin, depth)
+    }
+}
+
+// countNeutrons returns the number of live neutrons
+func (s *CollisionSystem) countNeutrons() int {
+    count := 0
+    for _, entityID := range s.entityManager.GetEntitiesWithComponent(s.factionID) {
+        factionComp, _ := s.entityManager.GetComponent(entityID, s.factionID)
+        if factionComp.(*components.Faction).Type == components.NeutronFaction {
+            count++
+        }
+    }
+    return count
+}
+
+// destroyEnemy removes a destroyed atom, first splitting it into fragments if its Enemy
+// component is set up for fission. Points are banked in the atom's fission chain and
+// only awarded once every atom split from the same original has been destroyed.
+func (s *CollisionSystem) destroyEnemy(entityID components.EntityID, pos rl.Vector2, points int, split bool) {
+    enemyComp, has := s.entityManager.GetComponent(entityID, s.enemyID)
+    if !has {
+        s.publishChainCleared(pos, points)
+        s.entityManager.DestroyEntity(entityID)
+        return
+    }
+    enemy := enemyComp.(*components.Enemy)
+    
+    // An atom that hasn't split before starts a new chain of its own
+    chain := enemy.Chain
+    if chain == nil {
+        chain = &components.FissionChain{Remaining: 1}
+    }
+    
+    chain.Points += points
+    chain.Remaining--
+    
+    if split {
+        chain.Remaining += s.splitEnemy(entityID, enemy, pos, chain)
+    }
+    
+    s.entityManager.DestroyEntity(entityID)
+    
+    // Whole chain cleared
+    if chain.Remaining <= 0 {
+        s.publishChainCleared(pos, chain.Points)
+        chain.Points = 0
+    }
+}
+
+// splitEnemy spawns the fission fragments of a destroyed atom and returns how many were created.
+// Fragments fly apart in evenly spaced directions on top of the parent's velocity. The spread
+// velocities cancel out, so the fragments' combined momentum equals the parent's.
+func (s *CollisionSystem) splitEnemy(
+    entityID components.EntityID,
+    enemy *components.Enemy,
+    pos rl.Vector2,
+    chain *components.FissionChain,
+) int {
+    if enemy.SplitMax <= 0 || s.factory == nil {
+        return 0
+    }
+    
+    count := s.rng.IntRange(enemy.SplitMin, enemy.SplitMax)
+    if count <= 0 {
+        return 0
+    }
+    
+    parentVel := rl.Vector2{X: 0, Y: 0}
+    if velComp, has := s.entityManager.GetComponent(entityID, s.velocityID); has {
+        parentVel = velComp.(*components.Velocity).Value
+    }
+    
+    // Fragments keep the parent's speed, adjusted for how fast their type normally is
+    parentPrefab := components.GetAtomPrefab(enemy.Type)
+    fragmentPrefab := components.GetAtomPrefab(enemy.SplitInto)
+    speed := enemy.Speed * fragmentPrefab.SpeedFactor / parentPrefab.SpeedFactor
+    
+    baseAngle := float64(s.rng.Range(0, 2*math.Pi))
+    for i := 0; i < count; i++ {
+        angle := baseAngle + 2*math.Pi*float64(i)/float64(count)
+        dir := rl.Vector2{X: float32(math.Cos(angle)), Y: float32(math.Sin(angle))}
+        
+        vel := rl.Vector2Add(parentVel, rl.Vector2Scale(dir, speed*constants.FissionSpreadFactor))
+        spawnPos := rl.Vector2Add(pos, rl.Vector2Scale(dir, fragmentPrefab.Radius))
+        
+        fragmentID := s.factory.CreateAtomWithSpeed(spawnPos.X, spawnPos.Y, vel.X, vel.Y, enemy.SplitInto, speed)
+        if fragmentComp, has := s.entityManager.GetComponent(fragmentID, s.enemyID); has {
+            fragment := fragmentComp.(*components.Enemy)
+            fragment.SpawnTimer = constants.FissionSpawnInvulnerable
+            fragment.Chain = chain
+        }
+    }
+    
+    s.spawnCollisionParticles(pos, 25, rl.SkyBlue, 3.0)
+    return count
+}
+
+// hitPlayer damages the player with an enemy shot or radiation. It returns false if
+// the player is invulnerable and the shot should keep flying.
+func (s *CollisionSystem) hitPlayer(playerEntity components.EntityID, playerPos rl.Vector2, damage int) bool {
+    if playerComp, has := s.entityManager.GetComponent(playerEntity, s.playerID); has {
+        player := playerComp.(*components.Player)
+        if player.IsInvulnerable() {
+            return false
+        }
+        
+        // A shield soaks up the hit without using up the player's invincibility
+        if s.isShielded(playerEntity) {
+            s.spawnCollisionParticles(playerPos, 10, components.EffectDefs[components.EffectShield].Color, 2.0)
+            return true
+        }
+        
+        // Brief invincibility after being hit
+        player.MakeInvulnerable(constants.HitInvulnerableTime * s.difficulty.Invulnerability)
+    }
+    
+    damage = s.difficulty.ScaleDamage(damage)
+    if healthComp, has := s.entityManager.GetComponent(playerEntity, s.healthID); has {
+        healthComp.(*components.Health).TakeDamage(damage)
+        s.events.Publish(Event{Type: EventPlayerDamaged, Entity: playerEntity, Position: playerPos, Value: damage})
+    }
+    
+    s.spawnCollisionParticles(playerPos, 20, rl.Red, 2.0)
+    return true
+}
+
+// isShielded reports whether the player has a running shield effect
+func (s *CollisionSystem) isShielded(playerEntity components.EntityID) bool {
+    effectsComp, has := s.entityManager.GetComponent(playerEntity, s.effectsID)
+    return has && effectsComp.(*components.ActiveEffects).Has(components.EffectShield)
+}
+
+// publishChainCleared lets the ScoreSystem pay out the points a fission chain banked
+func (s *CollisionSystem) publishChainCleared(pos rl.Vector2, points int) {
+    if points > 0 {
+        s.events.Publish(Event{Type: EventChainCleared, Position: pos, Value: points})
+    }
+}
+
+// hitScientist damages a scientist with an enemy shot; scientists without health die in one hit
+func (s *CollisionSystem) hitScientist(scientistEntity components.EntityID, scientistPos rl.Vector2) {
+    if healthComp, has := s.entityManager.GetComponent(scientistEntity, s.healthID); has {
+        if healthComp.(*components.Health).TakeDamage(1) {
+            s.spawnCollisionParticles(scientistPos, 5, rl.Red, 1.0)
+            return
+        }
+    }
+    
+    s.spawnCollisionParticles(scientistPos, 20, rl.Red, 2.5)
+    s.events.Publish(Event{Type: EventScientistKilled, Entity: scientistEntity, Position: scientistPos})
+    s.entityManager.DestroyEntity(scientistEntity)
+}
+
+// handleRadiationPulse damages the player, scientists and other atoms caught in a
+// decaying atom's radiation pulse. Atoms killed by radiation split but score nothing.
+func (s *CollisionSystem) handleRadiationPulse(e Event) {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.tagID, s.positionID) {
+        // The decay product isn't hurt by its own pulse
+        if entityID == e.Entity {
+            continue
+        }
+        
+        tagComp, _ := s.entityManager.GetComponent(entityID, s.tagID)
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        tag := tagComp.(*components.Tag)
+        pos := posComp.(*components.Position).Value
+        
+        reach := e.Radius
+        if collComp, has := s.entityManager.GetComponent(entityID, s.colliderID); has {
+            reach += collComp.(*components.Collider).Radius
+        }
+        if rl.Vector2Distance(pos, e.Position) > reach {
+            continue
+        }
+        
+        switch tag.Type {
+        case components.PlayerTag:
+            s.hitPlayer(entityID, pos, e.Value)
+            
+        case components.ScientistTag:
+            s.hitScientist(entityID, pos)
+            
+        case components.EnemyTag:
+            enemyComp, has := s.entityManager.GetComponent(entityID, s.enemyID)
+            if has && enemyComp.(*components.Enemy).IsSpawning() {
+                continue
+            }
+            healthComp, has := s.entityManager.GetComponent(entityID, s.healthID)
+            if has && !healthComp.(*components.Health).TakeDamage(e.Value) {
+                s.killEnemy(entityID, pos, nil, killedByRadiation)
+            }
+        }
+    }
+}
+
 // handleSpecialCollisions handles special collision types like scientists and rescue zones
 func (s *CollisionSystem) handleSpecialCollisions(entities []components.EntityID) {
     // Find the player entity first
@@ -288,8 +861,8 @@ func (s *CollisionSystem) handleSpecialCollisions(entities []components.EntityID
     }
     
     // Find all scientists
-    scientistID, _ := s.entityManager.GetEntityManager().Registry.GetID("Scientist")
-    scientists := s.entityManager.GetEntitiesWithComponents(s.tagID, s.positionID, scientistID)
+    scientistCompID, _ := s.entityManager.GetEntityManager().Registry.GetID("Scientist")
+    scientists := s.entityManager.GetEntitiesWithComponents(s.tagID, s.positionID, scientistCompID)
     
     // Find rescue zone
     var rescueZonePos *components.Position
@@ -308,37 +881,26 @@ func (s *CollisionSystem) handleSpecialCollisions(entities []components.EntityID
         }
     }
     
-    // Process scientist pickups and rescues
+    // Process scientist rescues (pickup and following are handled by the ScientistSystem)
     for _, scientistID := range scientists {
         // Get scientist components
         posComp, _ := s.entityManager.GetComponent(scientistID, s.positionID)
-        sciComp, _ := s.entityManager.GetComponent(scientistID, scientistID)
+        sciComp, _ := s.entityManager.GetComponent(scientistID, scientistCompID)
         collComp, _ := s.entityManager.GetComponent(scientistID, s.colliderID)
         
         scientistPos := posComp.(*components.Position)
         scientist := sciComp.(*components.Scientist)
         scientistCollider := collComp.(*components.Collider)
         
-        if scientist.State == components.Wandering {
-            // Check if player is near scientist to pick up
-            distance := rl.Vector2Distance(playerPos.Value, scientistPos.Value)
-            if distance < 50.0 {
-                scientist.State = components.FollowingPlayer
-                // Play pickup sound (handled separately)
-            }
-        } else if scientist.State == components.FollowingPlayer {
-            // Update position to follow player
-            scientistPos.Value.X = playerPos.Value.X + scientist.FollowOffset.X
-            scientistPos.Value.Y = playerPos.Value.Y + scientist.FollowOffset.Y
-            
+        if scientist.State == components.FollowingPlayer {
             // Check if in rescue zone
             if rescueZonePos != nil && rescueZoneCollider != nil {
                 if s.checkCollision(scientistPos.Value, scientistCollider, rescueZonePos.Value, rescueZoneCollider) {
                     // Scientist rescued!
-                    *s.scoreValue += 100
                     s.spawnCollisionParticles(scientistPos.Value, 20, rl.Green, 3.0)
                     
-                    // Play sound (handled separately)
+                    // Let interested systems know (e.g. the rescued counter)
+                    s.events.Publish(Event{Type: EventScientistRescued, Entity: scientistID, Position: scientistPos.Value})
                     
                     // Mark scientist as rescued and remove
                     scientist.State = components.Rescued
@@ -441,12 +1003,6 @@ func (s *CollisionSystem) spawnCollisionParticles(pos rl.Vector2, count int, col
     // In a real implementation, we'd create entities with Particle components
 }
 
-// spawnPowerUp creates a power-up entity at the given position
-func (s *CollisionSystem) spawnPowerUp(pos rl.Vector2) {
-    // This would actually create a power-up entity with the appropriate components
-    // In a real implementation, we'd add that logic here
-}
-
 // Draw is empty for CollisionSystem as it doesn't render anything
 func (s *CollisionSystem) Draw() {
     // Collision system doesn't need to draw anything
@@ -0,0 +1,126 @@
+// systems/decay_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "atomblaster/util"
+    "math"
+)
+
+// DecaySystem makes unstable atoms decay down their decay chain. Each atom draws its
+// decay time from its isotope half-life, and every decay releases a radiation pulse.
+type DecaySystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    velocityID    components.ComponentID
+    enemyID       components.ComponentID
+    factory       *components.EntityFactory
+    rng           *util.RNG
+    events        *EventBus
+}
+
+// NewDecaySystem creates a new decay system
+func NewDecaySystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    factory *components.EntityFactory,
+    rng *util.RNG,
+    events *EventBus,
+) *DecaySystem {
+    positionID, _ := registry.GetID("Position")
+    velocityID, _ := registry.GetID("Velocity")
+    enemyID, _ := registry.GetID("Enemy")
+
+    return &DecaySystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        velocityID:    velocityID,
+        enemyID:       enemyID,
+        factory:       factory,
+        rng:           rng,
+        events:        events,
+    }
+}
+
+// Update schedules a decay for every new unstable atom and decays atoms whose time is up
+func (s *DecaySystem) Update(dt float32) {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.enemyID, s.positionID) {
+        enemyComp, _ := s.entityManager.GetComponent(entityID, s.enemyID)
+        enemy := enemyComp.(*components.Enemy)
+
+        if enemy.HalfLife <= 0 {
+            continue
+        }
+
+        if !enemy.DecayScheduled {
+            enemy.DecayTimer = s.sampleDecayTime(enemy.HalfLife)
+            enemy.DecayScheduled = true
+            continue
+        }
+
+        enemy.DecayTimer -= dt
+        if enemy.DecayTimer <= 0 {
+            s.decay(entityID, enemy)
+        }
+    }
+}
+
+// sampleDecayTime draws how long an atom lives. Decay times are exponentially
+// distributed with a mean lifetime of halfLife / ln 2.
+func (s *DecaySystem) sampleDecayTime(halfLife float32) float32 {
+    return s.rng.ExpFloat32() * halfLife / math.Ln2
+}
+
+// decay replaces an atom with its decay product and releases a radiation pulse
+func (s *DecaySystem) decay(entityID components.EntityID, enemy *components.Enemy) {
+    rule, unstable := components.GetDecayRule(enemy.Type)
+    if !unstable || s.factory == nil {
+        enemy.HalfLife = 0
+        return
+    }
+
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    pos := posComp.(*components.Position).Value
+
+    var vx, vy float32
+    if velComp, has := s.entityManager.GetComponent(entityID, s.velocityID); has {
+        vel := velComp.(*components.Velocity).Value
+        vx, vy = vel.X, vel.Y
+    }
+
+    // The product keeps the atom's heading, at the speed its own type normally moves
+    speed := enemy.Speed * components.GetAtomPrefab(rule.Into).SpeedFactor / components.GetAtomPrefab(enemy.Type).SpeedFactor
+    if current := float32(math.Hypot(float64(vx), float64(vy))); current > 0 {
+        vx, vy = vx/current*speed, vy/current*speed
+    }
+
+    productID := s.factory.CreateAtomWithSpeed(pos.X, pos.Y, vx, vy, rule.Into, speed)
+    if productComp, has := s.entityManager.GetComponent(productID, s.enemyID); has {
+        // One atom replaces another, so the fission chain's count is unchanged
+        product := productComp.(*components.Enemy)
+        product.Chain = enemy.Chain
+        product.SpawnTimer = constants.DecaySpawnInvulnerable
+    }
+
+    s.entityManager.DestroyEntity(entityID)
+
+    s.events.Publish(Event{Type: EventAtomDecayed, Entity: productID, Position: pos, Value: int(rule.Into)})
+    s.events.Publish(Event{
+        Type:     EventRadiationPulse,
+        Entity:   productID,
+        Position: pos,
+        Value:    rule.PulseDamage,
+        Radius:   rule.PulseRadius,
+    })
+}
+
+// Draw is empty for DecaySystem; decay warnings are drawn by the RenderSystem
+func (s *DecaySystem) Draw() {
+    // Decay system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *DecaySystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.enemyID, s.positionID}
+}
@@ -0,0 +1,102 @@
+// systems/difficulty.go
+package systems
+
+import (
+    "atomblaster/constants"
+    "math"
+)
+
+// Difficulty identifies one of the difficulty presets
+type Difficulty int
+
+const (
+    DifficultyEasy Difficulty = iota
+    DifficultyNormal
+    DifficultyHard
+    DifficultyNightmare
+)
+
+// DifficultyProfile holds everything a difficulty changes. Spawning and the systems
+// that hurt the player or drop loot all read from the same profile.
+type DifficultyProfile struct {
+    Level           Difficulty
+    Name            string
+    EnemyCount      float32 // Multiplies the number of atoms on a level
+    EnemySpeed      float32 // Multiplies atom and boss speed
+    EnemyHealth     float32 // Multiplies atom and boss health
+    Damage          float32 // Multiplies damage dealt to the player
+    DropRate        float32 // Multiplies the chance of loot dropping
+    PlayerHealth    int     // Health the player starts and respawns with
+    DashCooldown    float32 // Seconds after a dash ends before the next one
+    Invulnerability float32 // Multiplies how long the player is invulnerable after a hit
+}
+
+// DifficultyProfiles lists the presets, indexed by Difficulty. Every setting gets
+// harder (or stays the same) from one preset to the next.
+var DifficultyProfiles = []DifficultyProfile{
+    {
+        Level: DifficultyEasy, Name: "Easy",
+        EnemyCount: 0.75, EnemySpeed: 0.8, EnemyHealth: 0.75, Damage: 1, DropRate: 1.4,
+        PlayerHealth: 5, DashCooldown: 0.7, Invulnerability: 1.5,
+    },
+    {
+        Level: DifficultyNormal, Name: "Normal",
+        EnemyCount: 1, EnemySpeed: 1, EnemyHealth: 1, Damage: 1, DropRate: 1,
+        PlayerHealth: constants.PlayerInitialHealth, DashCooldown: constants.DashCooldown, Invulnerability: 1,
+    },
+    {
+        Level: DifficultyHard, Name: "Hard",
+        EnemyCount: 1.25, EnemySpeed: 1.15, EnemyHealth: 1.25, Damage: 1, DropRate: 0.8,
+        PlayerHealth: 2, DashCooldown: 1.2, Invulnerability: 0.8,
+    },
+    {
+        Level: DifficultyNightmare, Name: "Nightmare",
+        EnemyCount: 1.5, EnemySpeed: 1.3, EnemyHealth: 1.5, Damage: 2, DropRate: 0.6,
+        PlayerHealth: 2, DashCooldown: 1.5, Invulnerability: 0.6,
+    },
+}
+
+// GetDifficulty returns the profile of a preset, falling back to Normal for unknown values
+func GetDifficulty(difficulty Difficulty) DifficultyProfile {
+    if difficulty < 0 || int(difficulty) >= len(DifficultyProfiles) {
+        difficulty = DifficultyNormal
+    }
+    return DifficultyProfiles[difficulty]
+}
+
+// DifficultyByName returns the preset with the given name, as recorded in saves and scores
+func DifficultyByName(name string) (DifficultyProfile, bool) {
+    for _, profile := range DifficultyProfiles {
+        if profile.Name == name {
+            return profile, true
+        }
+    }
+    return GetDifficulty(DifficultyNormal), false
+}
+
+// ScaleCount scales a number of enemies, never going below one
+func (p DifficultyProfile) ScaleCount(count int) int {
+    return scaleAtLeastOne(count, p.EnemyCount)
+}
+
+// ScaleHealth scales an enemy's health, never going below one
+func (p DifficultyProfile) ScaleHealth(health int) int {
+    return scaleAtLeastOne(health, p.EnemyHealth)
+}
+
+// ScaleDamage scales damage dealt to the player, never going below one
+func (p DifficultyProfile) ScaleDamage(damage int) int {
+    return scaleAtLeastOne(damage, p.Damage)
+}
+
+// scaleAtLeastOne multiplies a positive amount and rounds it, keeping it at one or more
+func scaleAtLeastOne(amount int, scale float32) int {
+    if amount <= 0 {
+        return amount
+    }
+    scaled := int(math.Round(float64(float32(amount) * scale)))
+    if scaled < 1 {
+        scaled = 1
+    }
+    return scaled
+}
@@ -0,0 +1,186 @@
+// systems/difficulty_director.go
+package systems
+
+import (
+    "atomblaster/components"
+    "fmt"
+)
+
+// DirectorTuning holds the numbers the difficulty director works with
+type DirectorTuning struct {
+    MinScale              float32 // Lowest any adjustment multiplier can go
+    MaxScale              float32 // Highest any adjustment multiplier can go
+    Step                  float32 // How far the multipliers move after one level
+    TargetDamagePerMinute float32 // Damage per minute a player at the right difficulty takes
+    SlowClear             float32 // Clearing slower than par times this counts as struggling
+    FastClear             float32 // Clearing faster than par times this counts as cruising
+}
+
+// DirectorValues is the tuning the director uses
+var DirectorValues = DirectorTuning{
+    MinScale:              0.75,
+    MaxScale:              1.25,
+    Step:                  0.05,
+    TargetDamagePerMinute: 2,
+    SlowClear:             1.25,
+    FastClear:             0.75,
+}
+
+// DirectorAdjustment holds the multipliers the director lays over the difficulty preset
+type DirectorAdjustment struct {
+    EnemyCount float32 `json:"enemy_count"`
+    EnemySpeed float32 `json:"enemy_speed"`
+    DropRate   float32 `json:"drop_rate"`
+}
+
+// DirectorLogEntry records one change the director made and why
+type DirectorLogEntry struct {
+    Level      int                `json:"level"`  // Level the change applies to
+    Reason     string             `json:"reason"` // How the player did on the attempt before it
+    Adjustment DirectorAdjustment `json:"adjustment"`
+}
+
+// String describes the entry for the log
+func (e DirectorLogEntry) String() string {
+    return fmt.Sprintf("level %d: %s -> enemies x%.2f, speed x%.2f, drops x%.2f",
+        e.Level, e.Reason, e.Adjustment.EnemyCount, e.Adjustment.EnemySpeed, e.Adjustment.DropRate)
+}
+
+// DifficultyDirector watches how the player is doing and, between levels, nudges enemy
+// counts, atom speed and drop rates up or down within DirectorValues' bounds. It only
+// acts when Enabled, which ranked runs never are.
+type DifficultyDirector struct {
+    Enabled    bool
+    Adjustment DirectorAdjustment
+    Log        []DirectorLogEntry // Every change made this run
+    attempted  bool               // Whether an attempt at a level is being tracked
+    completed  bool               // Whether the tracked attempt cleared the level
+    deaths     int
+    damage     int
+    levelTime  float32
+    parTime    float32
+}
+
+// NewDifficultyDirector creates a new, disabled difficulty director
+func NewDifficultyDirector(events *EventBus) *DifficultyDirector {
+    d := &DifficultyDirector{}
+    d.Reset()
+
+    if events != nil {
+        events.Subscribe(EventPlayerDamaged, func(e Event) { d.damage += e.Value })
+        events.Subscribe(EventPlayerDied, func(e Event) { d.deaths++ })
+        events.Subscribe(EventLevelCompleted, func(e Event) { d.completed = true })
+    }
+
+    return d
+}
+
+// Reset puts the adjustments back to neutral and clears the log for a new run
+func (d *DifficultyDirector) Reset() {
+    d.Adjustment = DirectorAdjustment{EnemyCount: 1, EnemySpeed: 1, DropRate: 1}
+    d.Log = nil
+    d.attempted = false
+}
+
+// StartLevel reviews the attempt that just ended, adjusts the difficulty if the player
+// struggled or cruised through it, and starts tracking the new level. It returns the log
+// entry for the change, or nil if nothing changed.
+func (d *DifficultyDirector) StartLevel(level int, parTime float32) *DirectorLogEntry {
+    var entry *DirectorLogEntry
+    if d.Enabled && d.attempted {
+        entry = d.review(level)
+    }
+
+    d.attempted = true
+    d.completed = false
+    d.deaths = 0
+    d.damage = 0
+    d.levelTime = 0
+    d.parTime = parTime
+    return entry
+}
+
+// review works out how the last attempt went and moves the adjustments one step
+func (d *DifficultyDirector) review(level int) *DirectorLogEntry {
+    tuning := DirectorValues
+
+    minutes := d.levelTime / 60
+    damageRate := float32(0)
+    if minutes > 0 {
+        damageRate = float32(d.damage) / minutes
+    }
+    reason := fmt.Sprintf("%d deaths, %.1f damage/min, %.0fs (par %.0fs)", d.deaths, damageRate, d.levelTime, d.parTime)
+    if !d.completed {
+        reason = "level not cleared, " + reason
+    }
+
+    struggling := !d.completed || d.deaths > 0 ||
+        damageRate > tuning.TargetDamagePerMinute*1.5 ||
+        (d.parTime > 0 && d.levelTime > d.parTime*tuning.SlowClear)
+    cruising := d.completed && d.deaths == 0 &&
+        damageRate <= tuning.TargetDamagePerMinute*0.5 &&
+        d.parTime > 0 && d.levelTime < d.parTime*tuning.FastClear
+
+    step := float32(0)
+    switch {
+    case struggling:
+        step = -tuning.Step
+    case cruising:
+        step = tuning.Step
+    default:
+        return nil
+    }
+
+    // Harder means more and faster atoms and fewer drops
+    previous := d.Adjustment
+    d.Adjustment.EnemyCount = clampScale(d.Adjustment.EnemyCount + step)
+    d.Adjustment.EnemySpeed = clampScale(d.Adjustment.EnemySpeed + step)
+    d.Adjustment.DropRate = clampScale(d.Adjustment.DropRate - step)
+    if d.Adjustment == previous {
+        return nil // Already at the bound
+    }
+
+    entry := DirectorLogEntry{Level: level, Reason: reason, Adjustment: d.Adjustment}
+    d.Log = append(d.Log, entry)
+    return &entry
+}
+
+// clampScale keeps an adjustment multiplier within the director's bounds
+func clampScale(scale float32) float32 {
+    if scale < DirectorValues.MinScale {
+        return DirectorValues.MinScale
+    }
+    if scale > DirectorValues.MaxScale {
+        return DirectorValues.MaxScale
+    }
+    return scale
+}
+
+// Apply returns a difficulty preset with the director's adjustments laid over it
+func (d *DifficultyDirector) Apply(base DifficultyProfile) DifficultyProfile {
+    if !d.Enabled {
+        return base
+    }
+
+    base.EnemyCount *= d.Adjustment.EnemyCount
+    base.EnemySpeed *= d.Adjustment.EnemySpeed
+    base.DropRate *= d.Adjustment.DropRate
+    return base
+}
+
+// Update times the current attempt
+func (d *DifficultyDirector) Update(dt float32) {
+    if d.attempted && !d.completed {
+        d.levelTime += dt
+    }
+}
+
+// Draw is empty for DifficultyDirector; it only changes numbers
+func (d *DifficultyDirector) Draw() {
+    // Difficulty director doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (d *DifficultyDirector) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{}
+}
@@ -0,0 +1,82 @@
+// systems/effect_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// EffectSystem counts down timed power-up effects and applies the ones that act on
+// the world, like the magnet pulling pickups in. Effects that change the player's own
+// stats are read where those stats are used, so they revert as soon as they expire.
+type EffectSystem struct {
+    entityManager   *components.EntityManager
+    positionID      components.ComponentID
+    tagID           components.ComponentID
+    activeEffectsID components.ComponentID
+}
+
+// NewEffectSystem creates a new effect system
+func NewEffectSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry) *EffectSystem {
+    positionID, _ := registry.GetID("Position")
+    tagID, _ := registry.GetID("Tag")
+    activeEffectsID, _ := registry.GetID("ActiveEffects")
+
+    return &EffectSystem{
+        entityManager:   entityManager,
+        positionID:      positionID,
+        tagID:           tagID,
+        activeEffectsID: activeEffectsID,
+    }
+}
+
+// Update counts down every running effect and pulls pickups toward magnetized entities
+func (s *EffectSystem) Update(dt float32) {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.activeEffectsID, s.positionID) {
+        effectsComp, _ := s.entityManager.GetComponent(entityID, s.activeEffectsID)
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        effects := effectsComp.(*components.ActiveEffects)
+
+        effects.Update(dt)
+
+        if radius := effects.Magnitude(components.EffectMagnet); radius > 0 {
+            s.pullPickups(posComp.(*components.Position).Value, radius, dt)
+        }
+    }
+}
+
+// pullPickups moves every power-up within radius toward the center
+func (s *EffectSystem) pullPickups(center rl.Vector2, radius float32, dt float32) {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.tagID, s.positionID) {
+        tagComp, _ := s.entityManager.GetComponent(entityID, s.tagID)
+        if tagComp.(*components.Tag).Type != components.PowerUpTag {
+            continue
+        }
+
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        position := posComp.(*components.Position)
+
+        toCenter := rl.Vector2Subtract(center, position.Value)
+        dist := rl.Vector2Length(toCenter)
+        if dist > radius || dist == 0 {
+            continue
+        }
+
+        step := constants.MagnetPullSpeed * dt
+        if step > dist {
+            step = dist
+        }
+        position.Value = rl.Vector2Add(position.Value, rl.Vector2Scale(toCenter, step/dist))
+    }
+}
+
+// Draw is empty for EffectSystem; effects are drawn by the RenderSystem and the HUD
+func (s *EffectSystem) Draw() {
+    // Effect system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *EffectSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.activeEffectsID, s.positionID}
+}
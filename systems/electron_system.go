@@ -0,0 +1,86 @@
+// systems/electron_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// ElectronSystem moves electron shells around their atoms, regenerates knocked off
+// electrons and removes electrons whose atom is gone
+type ElectronSystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    electronID    components.ComponentID
+    healthID      components.ComponentID
+}
+
+// NewElectronSystem creates a new electron system
+func NewElectronSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry) *ElectronSystem {
+    positionID, _ := registry.GetID("Position")
+    electronID, _ := registry.GetID("Electron")
+    healthID, _ := registry.GetID("Health")
+
+    return &ElectronSystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        electronID:    electronID,
+        healthID:      healthID,
+    }
+}
+
+// Update keeps every electron on its orbit and counts down regeneration
+func (s *ElectronSystem) Update(dt float32) {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.electronID, s.positionID) {
+        electronComp, _ := s.entityManager.GetComponent(entityID, s.electronID)
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+
+        electron := electronComp.(*components.Electron)
+        position := posComp.(*components.Position)
+
+        // Electrons go with their atom
+        parentPosComp, hasParent := s.entityManager.GetComponent(electron.Parent, s.positionID)
+        if !hasParent || s.isFinished(electron.Parent) {
+            s.entityManager.DestroyEntity(entityID)
+            continue
+        }
+        parentPos := parentPosComp.(*components.Position).Value
+
+        electron.Angle += electron.OrbitSpeed * dt
+        if electron.Angle > 2*math.Pi {
+            electron.Angle -= 2 * math.Pi
+        } else if electron.Angle < 0 {
+            electron.Angle += 2 * math.Pi
+        }
+
+        position.Value = rl.Vector2{
+            X: parentPos.X + float32(math.Cos(float64(electron.Angle)))*electron.OrbitRadius,
+            Y: parentPos.Y + float32(math.Sin(float64(electron.Angle)))*electron.OrbitRadius,
+        }
+
+        if !electron.Active {
+            electron.RegenTimer -= dt
+            if electron.RegenTimer <= 0 {
+                electron.Active = true
+                electron.RegenTimer = 0
+            }
+        }
+    }
+}
+
+// isFinished reports whether an atom has no health left
+func (s *ElectronSystem) isFinished(atomID components.EntityID) bool {
+    healthComp, has := s.entityManager.GetComponent(atomID, s.healthID)
+    return has && healthComp.(*components.Health).Current <= 0
+}
+
+// Draw is empty for ElectronSystem; electrons are drawn by the RenderSystem
+func (s *ElectronSystem) Draw() {
+    // Electron system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *ElectronSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.electronID, s.positionID}
+}
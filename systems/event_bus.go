@@ -0,0 +1,84 @@
+// systems/event_bus.go
+package systems
+
+import (
+    "atomblaster/components"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// EventType identifies a kind of gameplay event
+type EventType int
+
+const (
+    EventScientistPickedUp EventType = iota
+    EventScientistRescued
+    EventScientistKilled
+    EventChainReaction    // An atom was destroyed by a neutron; Value is the cascade depth
+    EventAtomsFused       // Two atoms fused; Value is the new EnemyType
+    EventAtomDecayed      // An atom decayed; Value is the new EnemyType
+    EventRadiationPulse   // Radiation burst; Value is the damage, Radius its reach
+    EventEnemyKilled      // An atom or boss was destroyed by the player; Value is its EnemyType
+    EventPlayerDied       // The player's health ran out; Value is the lives left
+    EventPlayerDamaged    // The player lost health; Value is the damage
+    EventEnemyHit         // An atom or boss was damaged but not destroyed
+    EventChainCleared     // Every atom of a fission chain is gone; Value is the points it banked
+    EventPowerUpCollected // The player picked up a power-up; Value is its PowerUpType
+    EventLevelCompleted   // The player flew through the open exit door; Value is the level
+    EventShotFired        // The player fired a volley; Value is the number of projectiles
+    EventShotHit          // A player projectile hit its first target
+    EventDashed           // The player started a dash
+)
+
+// Event describes something that happened during gameplay
+type Event struct {
+    Type     EventType
+    Entity   components.EntityID // Entity the event is about
+    Position rl.Vector2          // Where it happened
+    Value    int                 // Event-specific amount (damage, points, ...)
+    Radius   float32             // Area of effect for events that have one
+}
+
+// EventBus queues gameplay events and delivers them to subscribers once per frame,
+// so systems can react to each other without holding direct references
+type EventBus struct {
+    handlers map[EventType][]func(Event)
+    queue    []Event
+}
+
+// NewEventBus creates a new, empty event bus
+func NewEventBus() *EventBus {
+    return &EventBus{
+        handlers: make(map[EventType][]func(Event)),
+        queue:    make([]Event, 0),
+    }
+}
+
+// Subscribe registers a handler to be called for every event of the given type
+func (b *EventBus) Subscribe(eventType EventType, handler func(Event)) {
+    b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish queues an event for delivery on the next Dispatch
+func (b *EventBus) Publish(event Event) {
+    b.queue = append(b.queue, event)
+}
+
+// Dispatch delivers all queued events. Events published by handlers during
+// dispatch are delivered in the same call.
+func (b *EventBus) Dispatch() {
+    for len(b.queue) > 0 {
+        pending := b.queue
+        b.queue = make([]Event, 0)
+
+        for _, event := range pending {
+            for _, handler := range b.handlers[event.Type] {
+                handler(event)
+            }
+        }
+    }
+}
+
+// Clear drops any undelivered events, e.g. when a level is torn down
+func (b *EventBus) Clear() {
+    b.queue = b.queue[:0]
+}
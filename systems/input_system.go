@@ -17,8 +17,12 @@ type InputSystem struct {
     colliderID    components.ComponentID
     tagID         components.ComponentID
     lifetimeID    components.ComponentID
-    fireCooldown  float32
+    weaponID      components.ComponentID
+    effectsID     components.ComponentID
     currentState  *int
+    camera        *Camera
+    events        *EventBus
+    difficulty    *DifficultyProfile
     audio         interface{} // Would be a proper AudioSystem in the real implementation
 }
 
@@ -27,6 +31,9 @@ func NewInputSystem(
     entityManager *components.EntityManager,
     registry *components.ComponentTypeRegistry,
     currentState *int,
+    camera *Camera,
+    events *EventBus,
+    difficulty *DifficultyProfile,
     audio interface{},
 ) *InputSystem {
     positionID, _ := registry.GetID("Position")
@@ -35,6 +42,8 @@ func NewInputSystem(
     colliderID, _ := registry.GetID("Collider")
     tagID, _ := registry.GetID("Tag")
     lifetimeID, _ := registry.GetID("Lifetime")
+    weaponID, _ := registry.GetID("Weapon")
+    effectsID, _ := registry.GetID("ActiveEffects")
     
     return &InputSystem{
         entityManager: entityManager,
@@ -44,8 +53,12 @@ func NewInputSystem(
         colliderID:    colliderID,
         tagID:         tagID,
         lifetimeID:    lifetimeID,
-        fireCooldown:  0,
+        weaponID:      weaponID,
+        effectsID:     effectsID,
         currentState:  currentState,
+        camera:        camera,
+        events:        events,
+        difficulty:    difficulty,
         audio:         audio,
     }
 }
@@ -77,19 +90,28 @@ func (s *InputSystem) Update(dt float32) {
     velocity := velComp.(*components.Velocity)
     player := playerComp.(*components.Player)
     
+    // Timed power-up effects, if the player has any running
+    var effects *components.ActiveEffects
+    if effectsComp, has := s.entityManager.GetComponent(playerEntity, s.effectsID); has {
+        effects = effectsComp.(*components.ActiveEffects)
+    }
+    
     // Handle keyboard movement
-    s.handleMovementInput(player, velocity, dt)
+    s.handleMovementInput(player, effects, velocity, dt)
     
-    // Update dash state
-    if player.IsDashing {
-        player.DashTimer -= dt
-        if player.DashTimer <= 0 {
-            player.IsDashing = false
-        }
-    }
+    // Handle camera zoom
+    s.handleZoomInput()
+    
+    // Update dash, dash cooldown and invulnerability timers
+    player.UpdateTimers(dt, s.difficulty.DashCooldown)
     
-    // Handle shooting
-    s.handleShootingInput(player, position, dt)
+    // Handle weapon switching and shooting
+    if weaponComp, has := s.entityManager.GetComponent(playerEntity, s.weaponID); has {
+        weapon := weaponComp.(*components.Weapon)
+        s.applyRecoil(weapon, velocity, dt)
+        s.handleWeaponSwitching(weapon)
+        s.handleShootingInput(weapon, effects, position, dt)
+    }
 }
 
 // handleStateTransitions processes inputs for changing game states
@@ -119,7 +141,7 @@ func (s *InputSystem) handleStateTransitions() {
 }
 
 // handleMovementInput processes keyboard input for movement
-func (s *InputSystem) handleMovementInput(player *components.Player, velocity *components.Velocity, dt float32) {
+func (s *InputSystem) handleMovementInput(player *components.Player, effects *components.ActiveEffects, velocity *components.Velocity, dt float32) {
     // Reset velocity
     velocity.Value.X = 0
     velocity.Value.Y = 0
@@ -147,61 +169,136 @@ func (s *InputSystem) handleMovementInput(player *components.Player, velocity *c
         dy /= length
     }
     
-    // Apply movement speed
-    moveSpeed := player.Speed
+    if dx != 0 || dy != 0 {
+        player.Facing = rl.Vector2Normalize(rl.Vector2{X: dx, Y: dy})
+    }
+    
+    // Process dash input (space key)
+    if rl.IsKeyPressed(rl.KeySpace) && player.CanDash() {
+        player.StartDash(rl.Vector2{X: dx, Y: dy}, constants.DashDuration)
+        s.events.Publish(Event{Type: EventDashed})
+    }
+    
+    // A dash covers a fixed distance in its own direction, whatever the keys say
     if player.IsDashing {
-        moveSpeed = player.Speed * 3 // Dash is 3x normal speed
+        dashSpeed := float32(constants.DashDistance / constants.DashDuration)
+        velocity.Value = rl.Vector2Scale(player.DashDirection, dashSpeed)
+        return
     }
     
+    // Apply movement speed, boosted while a speed effect is running
+    moveSpeed := player.Speed * (1 + effects.Magnitude(components.EffectSpeedBoost))
+    
     velocity.Value.X = dx * moveSpeed
     velocity.Value.Y = dy * moveSpeed
+}
+
+// handleZoomInput processes the mouse wheel for zooming the camera
+func (s *InputSystem) handleZoomInput() {
+    if s.camera == nil {
+        return
+    }
     
-    // Process dash input (space key)
-    if rl.IsKeyPressed(rl.KeySpace) && !player.IsDashing {
-        player.IsDashing = true
-        player.DashTimer = 0.2
+    if wheel := rl.GetMouseWheelMove(); wheel != 0 {
+        s.camera.AdjustZoom(wheel * constants.CameraZoomStep)
+    }
+}
+
+// handleWeaponSwitching selects owned weapons with the number keys and cycles through them with Q
+func (s *InputSystem) handleWeaponSwitching(weapon *components.Weapon) {
+    weaponKeys := []int32{rl.KeyOne, rl.KeyTwo, rl.KeyThree, rl.KeyFour, rl.KeyFive}
+    for i, key := range weaponKeys {
+        if rl.IsKeyPressed(key) {
+            weapon.Select(components.WeaponType(i))
+        }
+    }
+    
+    if rl.IsKeyPressed(rl.KeyQ) {
+        weapon.Cycle()
     }
 }
 
-// handleShootingInput processes mouse input for shooting
-func (s *InputSystem) handleShootingInput(player *components.Player, position *components.Position, dt float32) {
+// applyRecoil adds the weapon's recoil to the player's movement and lets it wear off
+func (s *InputSystem) applyRecoil(weapon *components.Weapon, velocity *components.Velocity, dt float32) {
+    velocity.Value = rl.Vector2Add(velocity.Value, weapon.Kick)
+    weapon.Kick = rl.Vector2Scale(weapon.Kick, float32(math.Exp(float64(-constants.RecoilDamping*dt))))
+}
+
+// handleShootingInput processes mouse input for shooting. Holding the button fires the
+// current weapon at its fire rate; burst weapons fire the rest of a burst on their own.
+func (s *InputSystem) handleShootingInput(weapon *components.Weapon, effects *components.ActiveEffects, position *components.Position, dt float32) {
     // Update cooldown timer
-    s.fireCooldown -= dt
+    weapon.Cooldown -= dt
+    
+    // Only allow shooting if the player owns a weapon
+    if !weapon.HasAny() {
+        return
+    }
+    
+    def := weapon.Definition()
+    
+    // Fire the remaining volleys of a burst
+    if weapon.BurstLeft > 0 {
+        weapon.BurstTimer -= dt
+        if weapon.BurstTimer <= 0 {
+            s.fireVolley(weapon, def, position.Value)
+            weapon.BurstLeft--
+            weapon.BurstTimer = def.BurstInterval
+        }
+        return
+    }
     
-    // Only allow shooting if player has a gun and cooldown is expired
-    if player.HasGun && s.fireCooldown <= 0 && rl.IsMouseButtonDown(rl.MouseLeftButton) {
+    if weapon.Cooldown <= 0 && rl.IsMouseButtonDown(rl.MouseLeftButton) {
         // Reset cooldown
-        s.fireCooldown = constants.FireCooldownDuration
+        weapon.Cooldown = 1 / (def.FireRate * (1 + effects.Magnitude(components.EffectRapidFire)))
         
-        // Create bullet entity at player position
-        s.spawnBullet(position.Value)
+        s.fireVolley(weapon, def, position.Value)
+        weapon.BurstLeft = def.BurstCount - 1
+        weapon.BurstTimer = def.BurstInterval
         
         // Play sound (audio would be handled separately)
     }
 }
 
-// spawnBullet creates a new bullet entity
-func (s *InputSystem) spawnBullet(playerPos rl.Vector2) {
-    // Create bullet entity
-    entityID := s.entityManager.CreateEntity()
-    
-    // Calculate bullet direction based on mouse position
+// fireVolley fires one volley of the weapon toward the mouse, fanning the shots out
+// over the weapon's spread, and pushes the player back by the weapon's recoil
+func (s *InputSystem) fireVolley(weapon *components.Weapon, def components.WeaponDef, playerPos rl.Vector2) {
+    // Calculate aim direction based on mouse position (in world space)
     mousePos := rl.GetMousePosition()
-    dir := rl.Vector2Subtract(mousePos, playerPos)
+    if s.camera != nil {
+        mousePos = s.camera.ScreenToWorld(mousePos)
+    }
+    aim := rl.Vector2Normalize(rl.Vector2Subtract(mousePos, playerPos))
     
-    // Normalize direction
-    dir = rl.Vector2Normalize(dir)
+    for i := 0; i < def.ProjectileCount; i++ {
+        angle := float32(0)
+        if def.ProjectileCount > 1 {
+            angle = -def.Spread/2 + def.Spread*float32(i)/float32(def.ProjectileCount-1)
+        }
+        s.spawnProjectile(playerPos, rl.Vector2Rotate(aim, angle*rl.Deg2rad), def.Projectile)
+    }
+    
+    weapon.Kick = rl.Vector2Add(weapon.Kick, rl.Vector2Scale(aim, -def.Recoil))
+    s.events.Publish(Event{Type: EventShotFired, Position: playerPos, Value: def.ProjectileCount})
+}
+
+// spawnProjectile creates a new player projectile flying in the given direction
+func (s *InputSystem) spawnProjectile(playerPos rl.Vector2, dir rl.Vector2, prefab components.ProjectilePrefab) {
+    // Create projectile entity
+    entityID := s.entityManager.CreateEntity()
     
-    // Set bullet velocity
-    bulletSpeed := float32(constants.BulletSpeed)
-    bulletVel := rl.Vector2Scale(dir, bulletSpeed)
+    // Set projectile velocity
+    vel := rl.Vector2Scale(dir, prefab.Speed)
     
     // Add components to the entity
     s.entityManager.AddComponent(entityID, components.NewPosition(playerPos.X, playerPos.Y, s.entityManager.Registry))
-    s.entityManager.AddComponent(entityID, components.NewVelocity(bulletVel.X, bulletVel.Y, s.entityManager.Registry))
-    s.entityManager.AddComponent(entityID, components.NewCircleCollider(5, s.entityManager.Registry))
+    s.entityManager.AddComponent(entityID, components.NewVelocity(vel.X, vel.Y, s.entityManager.Registry))
+    s.entityManager.AddComponent(entityID, components.NewCircleCollider(prefab.Radius, s.entityManager.Registry))
     s.entityManager.AddComponent(entityID, components.NewTag(components.BulletTag, s.entityManager.Registry))
-    s.entityManager.AddComponent(entityID, components.NewLifetime(constants.BulletLifetime, s.entityManager.Registry))
+    s.entityManager.AddComponent(entityID, components.NewFaction(components.PlayerFaction, 0, s.entityManager.Registry))
+    s.entityManager.AddComponent(entityID, components.NewCascade(entityID, 0, s.entityManager.Registry))
+    s.entityManager.AddComponent(entityID, components.NewProjectile(prefab, s.entityManager.Registry))
+    s.entityManager.AddComponent(entityID, components.NewLifetime(prefab.Lifetime, s.entityManager.Registry))
 }
 
 // Draw is empty for InputSystem as it doesn't render anything
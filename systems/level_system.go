@@ -0,0 +1,155 @@
+// systems/level_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "fmt"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// LevelSystem unlocks the exit door when the level objectives are met and
+// advances to the next level once the player flies through it
+type LevelSystem struct {
+    entityManager   *components.EntityManager
+    doorID          components.ComponentID
+    scientistID     components.ComponentID
+    tagID           components.ComponentID
+    healthID        components.ComponentID
+    level           *int   // Pointer to the level in the game state
+    events          *EventBus
+    loadLevel       func() // Tears down the current level and builds the one in *level
+    completeGame    func() // Called when the player leaves the final level
+    transitionTimer float32
+}
+
+// NewLevelSystem creates a new level system
+func NewLevelSystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    level *int,
+    events *EventBus,
+    loadLevel func(),
+    completeGame func(),
+) *LevelSystem {
+    doorID, _ := registry.GetID("Door")
+    scientistID, _ := registry.GetID("Scientist")
+    tagID, _ := registry.GetID("Tag")
+    healthID, _ := registry.GetID("Health")
+
+    return &LevelSystem{
+        entityManager: entityManager,
+        doorID:        doorID,
+        scientistID:   scientistID,
+        tagID:         tagID,
+        healthID:      healthID,
+        level:         level,
+        events:        events,
+        loadLevel:     loadLevel,
+        completeGame:  completeGame,
+    }
+}
+
+// Update checks level objectives and handles the transition to the next level
+func (s *LevelSystem) Update(dt float32) {
+    // Wait for the "level complete" banner before loading the next level
+    if s.transitionTimer > 0 {
+        s.transitionTimer -= dt
+        if s.transitionTimer <= 0 {
+            s.advanceLevel()
+        }
+        return
+    }
+
+    objectivesMet := s.ObjectivesMet()
+
+    for _, entityID := range s.entityManager.GetEntitiesWithComponent(s.doorID) {
+        doorComp, _ := s.entityManager.GetComponent(entityID, s.doorID)
+        door := doorComp.(*components.Door)
+
+        // Unlock the door once everything on the level has been dealt with
+        if !door.Unlocked && objectivesMet {
+            door.Unlocked = true
+        }
+
+        // The collision system flags the door when the player flies into it
+        if door.Unlocked && door.PlayerReached {
+            door.PlayerReached = false
+            s.transitionTimer = constants.LevelTransitionTime
+            s.events.Publish(Event{Type: EventLevelCompleted, Entity: entityID, Value: *s.level})
+        }
+    }
+}
+
+// ObjectivesMet reports whether the exit door should be open: every scientist
+// has been rescued and no boss is left alive
+func (s *LevelSystem) ObjectivesMet() bool {
+    if len(s.entityManager.GetEntitiesWithComponent(s.scientistID)) > 0 {
+        return false
+    }
+
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.tagID, s.healthID) {
+        tagComp, _ := s.entityManager.GetComponent(entityID, s.tagID)
+        if tagComp.(*components.Tag).Type != components.BossTag {
+            continue
+        }
+
+        healthComp, _ := s.entityManager.GetComponent(entityID, s.healthID)
+        if healthComp.(*components.Health).Current > 0 {
+            return false
+        }
+    }
+
+    return true
+}
+
+// Reset cancels a level transition in progress, e.g. when the run is restarted during the banner
+func (s *LevelSystem) Reset() {
+    s.transitionTimer = 0
+}
+
+// IsTransitioning reports whether the level complete banner is showing
+func (s *LevelSystem) IsTransitioning() bool {
+    return s.transitionTimer > 0
+}
+
+// advanceLevel moves to the next level, or finishes the game after the last one
+func (s *LevelSystem) advanceLevel() {
+    s.transitionTimer = 0
+
+    if *s.level >= constants.MaxLevel {
+        s.completeGame()
+        return
+    }
+
+    *s.level++
+    s.loadLevel()
+}
+
+// Draw shows the level complete banner in screen space during a transition
+func (s *LevelSystem) Draw() {
+    if !s.IsTransitioning() {
+        return
+    }
+
+    rl.DrawRectangle(0, constants.ScreenHeight/2-50, constants.ScreenWidth, 100, rl.Fade(rl.Black, 0.6))
+
+    text := fmt.Sprintf("LEVEL %d COMPLETE", *s.level)
+    if *s.level >= constants.MaxLevel {
+        text = "MISSION COMPLETE"
+    }
+
+    textWidth := rl.MeasureText(text, 40)
+    rl.DrawText(
+        text,
+        int32(constants.ScreenWidth/2) - textWidth/2,
+        int32(constants.ScreenHeight/2 - 20),
+        40,
+        rl.Green,
+    )
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *LevelSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.doorID}
+}
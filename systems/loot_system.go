@@ -0,0 +1,230 @@
+// systems/loot_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "atomblaster/util"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// LootSystem rolls loot tables when enemies are destroyed and removes dropped pickups
+// that weren't collected in time. It keeps the pity timers and per-level drop counts.
+type LootSystem struct {
+    entityManager *components.EntityManager
+    tagID         components.ComponentID
+    lifetimeID    components.ComponentID
+    spriteID      components.ComponentID
+    playerID      components.ComponentID
+    weaponID      components.ComponentID
+    factory       *components.EntityFactory
+    rng           *util.RNG
+    level         *int                           // Pointer to the current level in the game state
+    difficulty    *DifficultyProfile             // Scales drop chances
+    pity          map[components.EnemyType]int   // Rolls since each enemy type last dropped something
+    dropped       map[components.PowerUpType]int // Power-ups dropped this level
+}
+
+// NewLootSystem creates a new loot system
+func NewLootSystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    factory *components.EntityFactory,
+    rng *util.RNG,
+    level *int,
+    difficulty *DifficultyProfile,
+    events *EventBus,
+) *LootSystem {
+    tagID, _ := registry.GetID("Tag")
+    lifetimeID, _ := registry.GetID("Lifetime")
+    spriteID, _ := registry.GetID("Sprite")
+    playerID, _ := registry.GetID("Player")
+    weaponID, _ := registry.GetID("Weapon")
+
+    s := &LootSystem{
+        entityManager: entityManager,
+        tagID:         tagID,
+        lifetimeID:    lifetimeID,
+        spriteID:      spriteID,
+        playerID:      playerID,
+        weaponID:      weaponID,
+        factory:       factory,
+        rng:           rng,
+        level:         level,
+        difficulty:    difficulty,
+        pity:          make(map[components.EnemyType]int),
+        dropped:       make(map[components.PowerUpType]int),
+    }
+
+    if events != nil {
+        events.Subscribe(EventEnemyKilled, s.handleEnemyKilled)
+    }
+
+    return s
+}
+
+// ResetLevel starts a new level's drop limits. Pity timers carry over, so a dry spell
+// at the end of one level still counts in the next.
+func (s *LootSystem) ResetLevel() {
+    s.dropped = make(map[components.PowerUpType]int)
+}
+
+// Reset clears the pity timers and drop counts for a new game
+func (s *LootSystem) Reset() {
+    s.pity = make(map[components.EnemyType]int)
+    s.ResetLevel()
+}
+
+// Update counts down the despawn timer of dropped pickups and makes them blink before they vanish
+func (s *LootSystem) Update(dt float32) {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.tagID, s.lifetimeID) {
+        tagComp, _ := s.entityManager.GetComponent(entityID, s.tagID)
+        if tagComp.(*components.Tag).Type != components.PowerUpTag {
+            continue
+        }
+
+        lifetimeComp, _ := s.entityManager.GetComponent(entityID, s.lifetimeID)
+        lifetime := lifetimeComp.(*components.Lifetime)
+        lifetime.Remaining -= dt
+        if lifetime.Remaining <= 0 {
+            s.entityManager.DestroyEntity(entityID)
+            continue
+        }
+
+        if spriteComp, has := s.entityManager.GetComponent(entityID, s.spriteID); has {
+            sprite := spriteComp.(*components.Sprite)
+            sprite.Tint.A = 255
+            if lifetime.Remaining < constants.LootBlinkTime && int(lifetime.Remaining*constants.LootBlinkRate)%2 == 0 {
+                sprite.Tint.A = 60
+            }
+        }
+    }
+}
+
+// handleEnemyKilled rolls the loot table of a destroyed enemy and drops the result where it died
+func (s *LootSystem) handleEnemyKilled(e Event) {
+    enemyType := components.EnemyType(e.Value)
+    table := components.GetLootTable(components.EnemyLoot[enemyType], *s.level)
+    if table == nil {
+        return
+    }
+
+    pity := s.pity[enemyType]
+    drops := s.Roll(table, &pity)
+    s.pity[enemyType] = pity
+
+    s.Drop(drops, e.Position, constants.LootDespawnTime)
+}
+
+// Roll evaluates a loot table and returns the power-ups it drops. pity counts the rolls
+// since the table last dropped a weighted entry; pass nil to skip the pity timer.
+func (s *LootSystem) Roll(table *components.LootTable, pity *int) []components.PowerUpType {
+    drops := make([]components.PowerUpType, 0, len(table.Guaranteed)+1)
+    drops = append(drops, table.Guaranteed...)
+
+    forced := pity != nil && table.PityAfter > 0 && *pity >= table.PityAfter
+    if !forced && !s.rng.Chance(table.DropChance*s.difficulty.DropRate) {
+        if pity != nil {
+            *pity++
+        }
+        return drops
+    }
+
+    if powerUp, ok := s.pickEntry(table.Entries); ok {
+        drops = append(drops, powerUp)
+        if pity != nil {
+            *pity = 0
+        }
+    }
+
+    return drops
+}
+
+// pickEntry chooses one entry by weight, leaving out power-ups that reached their limit this level
+func (s *LootSystem) pickEntry(entries []components.LootEntry) (components.PowerUpType, bool) {
+    total := 0
+    for _, entry := range entries {
+        if s.available(entry) {
+            total += entry.Weight
+        }
+    }
+    if total <= 0 {
+        return 0, false
+    }
+
+    pick := s.rng.Intn(total)
+    for _, entry := range entries {
+        if !s.available(entry) {
+            continue
+        }
+        if pick < entry.Weight {
+            return entry.PowerUp, true
+        }
+        pick -= entry.Weight
+    }
+
+    return 0, false
+}
+
+// available reports whether an entry can still drop this level
+func (s *LootSystem) available(entry components.LootEntry) bool {
+    return entry.Weight > 0 && (entry.MaxPerLevel <= 0 || s.dropped[entry.PowerUp] < entry.MaxPerLevel)
+}
+
+// Drop spawns power-ups around a position, spread out so they don't overlap. Pickups
+// with a despawn time vanish if they aren't collected in time; 0 keeps them for good.
+func (s *LootSystem) Drop(drops []components.PowerUpType, pos rl.Vector2, despawn float32) {
+    for i, powerUp := range drops {
+        dropPos := pos
+        if len(drops) > 1 {
+            angle := 2 * math.Pi * float64(i) / float64(len(drops))
+            dropPos.X += float32(math.Cos(angle)) * constants.LootScatter
+            dropPos.Y += float32(math.Sin(angle)) * constants.LootScatter
+        }
+        s.Spawn(powerUp, dropPos, despawn)
+    }
+}
+
+// Spawn creates a single pickup and counts it against the level's drop limits
+func (s *LootSystem) Spawn(powerUp components.PowerUpType, pos rl.Vector2, despawn float32) components.EntityID {
+    if s.factory == nil {
+        return 0
+    }
+
+    var pickupID components.EntityID
+    if powerUp == components.PowerUpWeapon {
+        pickupID = s.factory.CreateWeaponPowerUp(pos.X, pos.Y, s.chooseWeapon())
+    } else {
+        pickupID = s.factory.CreatePowerUp(pos.X, pos.Y, powerUp)
+    }
+
+    if despawn > 0 {
+        s.entityManager.AddComponent(pickupID, components.NewLifetime(despawn, s.entityManager.Registry))
+    }
+
+    s.dropped[powerUp]++
+    return pickupID
+}
+
+// chooseWeapon picks the weapon a weapon pickup grants: the blaster while the player
+// has nothing, and any of the other weapons after that
+func (s *LootSystem) chooseWeapon() components.WeaponType {
+    for _, playerEntity := range s.entityManager.GetEntitiesWithComponents(s.playerID, s.weaponID) {
+        weaponComp, _ := s.entityManager.GetComponent(playerEntity, s.weaponID)
+        if !weaponComp.(*components.Weapon).HasAny() {
+            return components.WeaponBlaster
+        }
+    }
+    return components.WeaponType(s.rng.IntRange(int(components.WeaponSpread), int(components.WeaponBouncer)))
+}
+
+// Draw is empty for LootSystem; pickups are drawn by the RenderSystem
+func (s *LootSystem) Draw() {
+    // Loot system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *LootSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.tagID, s.lifetimeID}
+}
@@ -3,7 +3,7 @@ package systems
 
 import (
     "atomblaster/components"
-    "atomblaster/constants"
+    rl "github.com/gen2brain/raylib-go/raylib"
 )
 
 // MovementSystem handles updating positions based on velocities
@@ -11,17 +11,22 @@ type MovementSystem struct {
     entityManager *components.EntityManager
     positionID    components.ComponentID
     velocityID    components.ComponentID
+    projectileID  components.ComponentID
+    worldBounds   *rl.Rectangle // Pointer to the world bounds in the game state
 }
 
 // NewMovementSystem creates a new movement system
-func NewMovementSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry) *MovementSystem {
+func NewMovementSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry, worldBounds *rl.Rectangle) *MovementSystem {
     positionID, _ := registry.GetID("Position")
     velocityID, _ := registry.GetID("Velocity")
+    projectileID, _ := registry.GetID("Projectile")
     
     return &MovementSystem{
         entityManager: entityManager,
         positionID:    positionID,
         velocityID:    velocityID,
+        projectileID:  projectileID,
+        worldBounds:   worldBounds,
     }
 }
 
@@ -42,8 +47,13 @@ func (s *MovementSystem) Update(dt float32) {
         position.Value.X += velocity.Value.X * dt
         position.Value.Y += velocity.Value.Y * dt
         
-        // Optional: Handle screen bounds
-        // This keeps entities within the screen bounds, with a small margin
+        // Projectiles leave or ricochet off the world edge in the ProjectileSystem
+        if _, isProjectile := s.entityManager.GetComponent(entityID, s.projectileID); isProjectile {
+            continue
+        }
+        
+        // Optional: Handle world bounds
+        // This keeps entities within the world bounds, with a small margin
         // You might want different behavior for some entities
         
         // Check if entity has a collider component to determine bounds
@@ -59,24 +69,26 @@ func (s *MovementSystem) Update(dt float32) {
                 margin = (collider.Width + collider.Height) / 4
             }
             
-            // Constrain position to screen bounds (with margin)
-            if position.Value.X < margin {
-                position.Value.X = margin
+            // Constrain position to world bounds (with margin)
+            bounds := *s.worldBounds
+            
+            if position.Value.X < bounds.X+margin {
+                position.Value.X = bounds.X + margin
                 velocity.Value.X *= -1 // Bounce
             }
             
-            if position.Value.X > constants.ScreenWidth-margin {
-                position.Value.X = constants.ScreenWidth - margin
+            if position.Value.X > bounds.X+bounds.Width-margin {
+                position.Value.X = bounds.X + bounds.Width - margin
                 velocity.Value.X *= -1 // Bounce
             }
             
-            if position.Value.Y < margin {
-                position.Value.Y = margin
+            if position.Value.Y < bounds.Y+margin {
+                position.Value.Y = bounds.Y + margin
                 velocity.Value.Y *= -1 // Bounce
             }
             
-            if position.Value.Y > constants.ScreenHeight-margin {
-                position.Value.Y = constants.ScreenHeight - margin
+            if position.Value.Y > bounds.Y+bounds.Height-margin {
+                position.Value.Y = bounds.Y + bounds.Height - margin
                 velocity.Value.Y *= -1 // Bounce
             }
         }
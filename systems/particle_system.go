@@ -13,6 +13,7 @@ type ParticleSystem struct {
     positionID    components.ComponentID
     velocityID    components.ComponentID
     lifetimeID    components.ComponentID
+    projectileID  components.ComponentID
     particleQueue []ParticleSpawnRequest
 }
 
@@ -29,12 +30,14 @@ func NewParticleSystem(entityManager *components.EntityManager, registry *compon
     positionID, _ := registry.GetID("Position")
     velocityID, _ := registry.GetID("Velocity")
     lifetimeID, _ := registry.GetID("Lifetime")
+    projectileID, _ := registry.GetID("Projectile")
     
     return &ParticleSystem{
         entityManager: entityManager,
         positionID:    positionID,
         velocityID:    velocityID,
         lifetimeID:    lifetimeID,
+        projectileID:  projectileID,
         particleQueue: make([]ParticleSpawnRequest, 0),
     }
 }
@@ -54,6 +57,11 @@ func (s *ParticleSystem) Update(dt float32) {
     
     // Update each particle
     for _, entityID := range entities {
+        // Projectile lifetimes are counted down by the ProjectileSystem
+        if _, isProjectile := s.entityManager.GetComponent(entityID, s.projectileID); isProjectile {
+            continue
+        }
+        
         // Get components
         lifetimeComp, _ := s.entityManager.GetComponent(entityID, s.lifetimeID)
         lifetime := lifetimeComp.(*components.Lifetime)
@@ -0,0 +1,186 @@
+// systems/projectile_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/util"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// ProjectileSystem owns every projectile after it is fired: it counts down lifetimes,
+// removes shots that leave the world and applies ricochet, homing and gravity
+type ProjectileSystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    velocityID    components.ComponentID
+    projectileID  components.ComponentID
+    lifetimeID    components.ComponentID
+    colliderID    components.ComponentID
+    tagID         components.ComponentID
+    worldBounds   *rl.Rectangle // Pointer to the world bounds in the game state
+}
+
+// NewProjectileSystem creates a new projectile system
+func NewProjectileSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry, worldBounds *rl.Rectangle) *ProjectileSystem {
+    positionID, _ := registry.GetID("Position")
+    velocityID, _ := registry.GetID("Velocity")
+    projectileID, _ := registry.GetID("Projectile")
+    lifetimeID, _ := registry.GetID("Lifetime")
+    colliderID, _ := registry.GetID("Collider")
+    tagID, _ := registry.GetID("Tag")
+
+    return &ProjectileSystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        velocityID:    velocityID,
+        projectileID:  projectileID,
+        lifetimeID:    lifetimeID,
+        colliderID:    colliderID,
+        tagID:         tagID,
+        worldBounds:   worldBounds,
+    }
+}
+
+// Update ages, steers and bounds-checks every projectile. It runs after the
+// MovementSystem, so positions are already up to date for this frame.
+func (s *ProjectileSystem) Update(dt float32) {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.projectileID, s.positionID, s.velocityID) {
+        projectileComp, _ := s.entityManager.GetComponent(entityID, s.projectileID)
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        velComp, _ := s.entityManager.GetComponent(entityID, s.velocityID)
+
+        projectile := projectileComp.(*components.Projectile)
+        position := posComp.(*components.Position)
+        velocity := velComp.(*components.Velocity)
+
+        // Shots fizzle out at the end of their lifetime
+        if lifetimeComp, has := s.entityManager.GetComponent(entityID, s.lifetimeID); has {
+            lifetime := lifetimeComp.(*components.Lifetime)
+            lifetime.Remaining -= dt
+            if lifetime.Remaining <= 0 {
+                s.entityManager.DestroyEntity(entityID)
+                continue
+            }
+        }
+
+        if !s.updateBounds(projectile, position, velocity, entityID) {
+            s.entityManager.DestroyEntity(entityID)
+            continue
+        }
+
+        if projectile.Gravity != 0 {
+            velocity.Value.Y += projectile.Gravity * dt
+        }
+
+        if projectile.TurnRate > 0 {
+            if targetPos, found := s.nearestTarget(position.Value, projectile); found {
+                s.steer(velocity, rl.Vector2Subtract(targetPos, position.Value), projectile.TurnRate*dt)
+            }
+        }
+    }
+}
+
+// updateBounds bounces a projectile off the edge of the world while it has ricochets left.
+// It returns false once the projectile has left the world for good.
+func (s *ProjectileSystem) updateBounds(
+    projectile *components.Projectile,
+    position *components.Position,
+    velocity *components.Velocity,
+    entityID components.EntityID,
+) bool {
+    var radius float32
+    if colliderComp, has := s.entityManager.GetComponent(entityID, s.colliderID); has {
+        radius = colliderComp.(*components.Collider).Radius
+    }
+
+    bounds := *s.worldBounds
+    minX, maxX := bounds.X+radius, bounds.X+bounds.Width-radius
+    minY, maxY := bounds.Y+radius, bounds.Y+bounds.Height-radius
+
+    outX := position.Value.X < minX || position.Value.X > maxX
+    outY := position.Value.Y < minY || position.Value.Y > maxY
+    if !outX && !outY {
+        return true
+    }
+
+    if projectile.Ricochet <= 0 {
+        return false
+    }
+    projectile.Ricochet--
+
+    // Reflect off the edges that were crossed
+    if outX {
+        position.Value.X = util.ClampValue(position.Value.X, minX, maxX)
+        velocity.Value.X *= -1
+    }
+    if outY {
+        position.Value.Y = util.ClampValue(position.Value.Y, minY, maxY)
+        velocity.Value.Y *= -1
+    }
+
+    // A ricochet can hit the same targets again
+    projectile.Hit = make(map[components.EntityID]struct{})
+    return true
+}
+
+// nearestTarget finds the closest atom or boss the projectile hasn't hit yet
+func (s *ProjectileSystem) nearestTarget(pos rl.Vector2, projectile *components.Projectile) (rl.Vector2, bool) {
+    var nearest rl.Vector2
+    found := false
+    bestDist := float32(math.MaxFloat32)
+
+    for _, targetID := range s.entityManager.GetEntitiesWithComponents(s.tagID, s.positionID) {
+        tagComp, _ := s.entityManager.GetComponent(targetID, s.tagID)
+        tagType := tagComp.(*components.Tag).Type
+        if tagType != components.EnemyTag && tagType != components.BossTag {
+            continue
+        }
+        if projectile.HasHit(targetID) {
+            continue
+        }
+
+        targetComp, _ := s.entityManager.GetComponent(targetID, s.positionID)
+        targetPos := targetComp.(*components.Position).Value
+        if dist := rl.Vector2Distance(pos, targetPos); dist < bestDist {
+            bestDist = dist
+            nearest = targetPos
+            found = true
+        }
+    }
+
+    return nearest, found
+}
+
+// steer rotates the velocity toward the desired direction by at most maxTurn radians, keeping its speed
+func (s *ProjectileSystem) steer(velocity *components.Velocity, desired rl.Vector2, maxTurn float32) {
+    current := float32(math.Atan2(float64(velocity.Value.Y), float64(velocity.Value.X)))
+    wanted := float32(math.Atan2(float64(desired.Y), float64(desired.X)))
+
+    // Shortest signed angle from the current heading to the wanted one
+    diff := wanted - current
+    for diff > math.Pi {
+        diff -= 2 * math.Pi
+    }
+    for diff < -math.Pi {
+        diff += 2 * math.Pi
+    }
+
+    if diff > maxTurn {
+        diff = maxTurn
+    } else if diff < -maxTurn {
+        diff = -maxTurn
+    }
+
+    velocity.Value = rl.Vector2Rotate(velocity.Value, diff)
+}
+
+// Draw is empty for ProjectileSystem; projectiles are drawn by the RenderSystem
+func (s *ProjectileSystem) Draw() {
+    // Projectile system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *ProjectileSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.projectileID, s.positionID, s.velocityID}
+}
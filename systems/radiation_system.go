@@ -0,0 +1,207 @@
+// systems/radiation_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "atomblaster/util"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// RadiationSystem builds up the player's radiation dose near atoms and inside hazard zones,
+// drains health once the dose passes the sickness thresholds and drives the Geiger counter
+type RadiationSystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    colliderID    components.ComponentID
+    tagID         components.ComponentID
+    playerID      components.ComponentID
+    healthID      components.ComponentID
+    enemyID       components.ComponentID
+    hazardZoneID  components.ComponentID
+    events        *EventBus
+    difficulty    *DifficultyProfile // Scales radiation sickness damage
+    playClick     func()             // Plays one Geiger counter click
+    DoseRate      float32            // Dose per second the player is currently receiving
+}
+
+// NewRadiationSystem creates a new radiation system
+func NewRadiationSystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    events *EventBus,
+    difficulty *DifficultyProfile,
+    playClick func(),
+) *RadiationSystem {
+    positionID, _ := registry.GetID("Position")
+    colliderID, _ := registry.GetID("Collider")
+    tagID, _ := registry.GetID("Tag")
+    playerID, _ := registry.GetID("Player")
+    healthID, _ := registry.GetID("Health")
+    enemyID, _ := registry.GetID("Enemy")
+    hazardZoneID, _ := registry.GetID("HazardZone")
+
+    return &RadiationSystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        colliderID:    colliderID,
+        tagID:         tagID,
+        playerID:      playerID,
+        healthID:      healthID,
+        enemyID:       enemyID,
+        hazardZoneID:  hazardZoneID,
+        events:        events,
+        difficulty:    difficulty,
+        playClick:     playClick,
+    }
+}
+
+// Update adds this frame's dose to the player, lets it fall off and applies radiation sickness
+func (s *RadiationSystem) Update(dt float32) {
+    playerEntities := s.entityManager.GetEntitiesWithComponents(s.playerID, s.positionID)
+    if len(playerEntities) == 0 {
+        s.DoseRate = 0
+        return
+    }
+
+    playerEntity := playerEntities[0]
+    playerComp, _ := s.entityManager.GetComponent(playerEntity, s.playerID)
+    posComp, _ := s.entityManager.GetComponent(playerEntity, s.positionID)
+    player := playerComp.(*components.Player)
+    playerPos := posComp.(*components.Position).Value
+
+    s.DoseRate = s.atomDoseRate(playerPos) + s.hazardDoseRate(playerPos)
+
+    // The dose falls off slowly on its own, and quickly at the rescue zone
+    decay := float32(constants.RadiationDecayRate)
+    if s.inRescueZone(playerPos) {
+        decay = constants.RescueZoneDecayRate
+    }
+
+    player.RadiationDose += (s.DoseRate - decay) * dt
+    player.RadiationDose = util.ClampValue(player.RadiationDose, 0, constants.MaxRadiationDose)
+
+    s.updateSickness(playerEntity, player, dt)
+    s.updateGeiger(dt)
+}
+
+// atomDoseRate adds up the radiation of every atom near the position. An atom's
+// radiation is strongest at its edge and fades out linearly over AtomRadiationRange.
+func (s *RadiationSystem) atomDoseRate(pos rl.Vector2) float32 {
+    var rate float32
+
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.enemyID, s.positionID) {
+        enemyComp, _ := s.entityManager.GetComponent(entityID, s.enemyID)
+        atomPosComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        prefab := components.GetAtomPrefab(enemyComp.(*components.Enemy).Type)
+        if prefab.Radiation <= 0 {
+            continue
+        }
+
+        dist := rl.Vector2Distance(pos, atomPosComp.(*components.Position).Value) - prefab.Radius
+        if dist < 0 {
+            dist = 0
+        }
+        if dist < constants.AtomRadiationRange {
+            rate += prefab.Radiation * (1 - dist/constants.AtomRadiationRange)
+        }
+    }
+
+    return rate
+}
+
+// hazardDoseRate returns the dose rate of every hazard zone the position is inside
+func (s *RadiationSystem) hazardDoseRate(pos rl.Vector2) float32 {
+    var rate float32
+
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.hazardZoneID, s.positionID) {
+        zoneComp, _ := s.entityManager.GetComponent(entityID, s.hazardZoneID)
+        zonePosComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        zone := zoneComp.(*components.HazardZone)
+
+        if rl.Vector2Distance(pos, zonePosComp.(*components.Position).Value) <= zone.Radius {
+            rate += zone.DoseRate
+        }
+    }
+
+    return rate
+}
+
+// inRescueZone reports whether the position is inside a rescue zone
+func (s *RadiationSystem) inRescueZone(pos rl.Vector2) bool {
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.tagID, s.positionID, s.colliderID) {
+        tagComp, _ := s.entityManager.GetComponent(entityID, s.tagID)
+        if tagComp.(*components.Tag).Type != components.RescueZoneTag {
+            continue
+        }
+
+        zonePosComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        collComp, _ := s.entityManager.GetComponent(entityID, s.colliderID)
+        bounds := collComp.(*components.Collider).GetBounds(zonePosComp.(*components.Position).Value)
+        if rl.CheckCollisionPointRec(pos, bounds) {
+            return true
+        }
+    }
+
+    return false
+}
+
+// updateSickness drains the player's health while the dose is past a threshold.
+// Each threshold passed makes the drain faster.
+func (s *RadiationSystem) updateSickness(playerEntity components.EntityID, player *components.Player, dt float32) {
+    level := RadiationLevel(player.RadiationDose)
+    if level == 0 {
+        player.DoseDrainTimer = 0
+        return
+    }
+
+    player.DoseDrainTimer += dt
+    if player.DoseDrainTimer < constants.RadiationDrainInterval/float32(level) {
+        return
+    }
+    player.DoseDrainTimer = 0
+
+    if healthComp, has := s.entityManager.GetComponent(playerEntity, s.healthID); has {
+        damage := s.difficulty.ScaleDamage(1)
+        healthComp.(*components.Health).TakeDamage(damage)
+        s.events.Publish(Event{Type: EventPlayerDamaged, Entity: playerEntity, Value: damage})
+    }
+}
+
+// updateGeiger plays clicks at random, more often the higher the dose rate
+func (s *RadiationSystem) updateGeiger(dt float32) {
+    if s.playClick == nil {
+        return
+    }
+
+    rate := constants.GeigerBackgroundRate + constants.GeigerClicksPerDose*s.DoseRate
+    if rate > constants.MaxGeigerRate {
+        rate = constants.MaxGeigerRate
+    }
+
+    // Cosmetic only, so it doesn't draw from the gameplay RNG and shift it with the frame rate
+    if float32(rl.GetRandomValue(0, 9999))/10000.0 < rate*dt {
+        s.playClick()
+    }
+}
+
+// RadiationLevel returns how many sickness thresholds a dose has passed
+func RadiationLevel(dose float32) int {
+    level := 0
+    for _, threshold := range constants.RadiationThresholds {
+        if dose >= threshold {
+            level++
+        }
+    }
+    return level
+}
+
+// Draw is empty for RadiationSystem; hazard zones are drawn by the RenderSystem
+func (s *RadiationSystem) Draw() {
+    // Radiation system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *RadiationSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.playerID, s.positionID}
+}
@@ -3,6 +3,8 @@ package systems
 
 import (
     "atomblaster/components"
+    "atomblaster/constants"
+    "math"
     rl "github.com/gen2brain/raylib-go/raylib"
 )
 
@@ -12,11 +14,12 @@ type RenderSystem struct {
     positionID    components.ComponentID
     spriteID      components.ComponentID
     background    rl.Texture2D
+    worldBounds   *rl.Rectangle // Pointer to the world bounds in the game state
     debugMode     bool
 }
 
 // NewRenderSystem creates a new render system
-func NewRenderSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry, background rl.Texture2D) *RenderSystem {
+func NewRenderSystem(entityManager *components.EntityManager, registry *components.ComponentTypeRegistry, background rl.Texture2D, worldBounds *rl.Rectangle) *RenderSystem {
     positionID, _ := registry.GetID("Position")
     spriteID, _ := registry.GetID("Sprite")
     
@@ -25,6 +28,7 @@ func NewRenderSystem(entityManager *components.EntityManager, registry *componen
         positionID:    positionID,
         spriteID:      spriteID,
         background:    background,
+        worldBounds:   worldBounds,
         debugMode:     false,
     }
 }
@@ -34,10 +38,11 @@ func (s *RenderSystem) Update(dt float32) {
     // Rendering system doesn't need to update anything
 }
 
-// Draw renders all entities with Position and Sprite components
+// Draw renders all entities with Position and Sprite components.
+// It draws in world coordinates, so it should be called between Camera.Begin and Camera.End
 func (s *RenderSystem) Draw() {
     // Draw background
-    rl.DrawTexture(s.background, 0, 0, rl.White)
+    s.drawBackground()
     
     // Get all entities with both Position and Sprite components
     entities := s.entityManager.GetEntitiesWithComponents(s.positionID, s.spriteID)
@@ -52,6 +57,11 @@ func (s *RenderSystem) Draw() {
         position := posComp.(*components.Position)
         sprite := spriteComp.(*components.Sprite)
         
+        // The player blinks while invulnerable after a hit
+        if s.isFlickering(entityID) {
+            continue
+        }
+        
         // Set up destination rectangle
         width := sprite.SourceRect.Width * sprite.Scale
         height := sprite.SourceRect.Height * sprite.Scale
@@ -89,6 +99,26 @@ func (s *RenderSystem) Draw() {
     s.drawSpecialEntities()
 }
 
+// drawBackground tiles the background texture across the whole world
+func (s *RenderSystem) drawBackground() {
+    bounds := *s.worldBounds
+    
+    // Fall back to a single draw if the texture failed to load
+    if s.background.Width <= 0 || s.background.Height <= 0 {
+        rl.DrawTexture(s.background, int32(bounds.X), int32(bounds.Y), rl.White)
+        return
+    }
+    
+    for y := bounds.Y; y < bounds.Y+bounds.Height; y += float32(s.background.Height) {
+        for x := bounds.X; x < bounds.X+bounds.Width; x += float32(s.background.Width) {
+            rl.DrawTexture(s.background, int32(x), int32(y), rl.White)
+        }
+    }
+    
+    // Outline the edge of the world so the player can see where it ends
+    rl.DrawRectangleLinesEx(bounds, 4, rl.Fade(rl.SkyBlue, 0.6))
+}
+
 // drawDebugColliders draws debug visualization for colliders
 func (s *RenderSystem) drawDebugColliders(entityID components.EntityID, position rl.Vector2) {
     // Get collider component ID
@@ -139,9 +169,32 @@ func (s *RenderSystem) drawSpecialEntities() {
         // Draw rescue zone
         if tag.Type == components.RescueZoneTag {
             s.drawRescueZone(entityID)
-        } // Draw door
-        else if tag.Type == components.DoorTag {
+        } else if tag.Type == components.DoorTag {
+            // Draw door
             s.drawDoor(entityID)
+        } else if tag.Type == components.ScientistTag {
+            // Draw scientist
+            s.drawScientist(entityID)
+        } else if tag.Type == components.BossTag {
+            // Draw boss health bar and dash telegraph
+            s.drawBossOverlay(entityID)
+        } else if tag.Type == components.BulletTag {
+            // Draw neutrons and shots without a sprite
+            s.drawProjectile(entityID)
+        } else if tag.Type == components.EnemyTag {
+            // Draw the glow around fused heavy atoms and the warning before a decay
+            s.drawAtomTier(entityID)
+            s.drawDecayWarning(entityID)
+        } else if tag.Type == components.ElectronTag {
+            // Draw electron shell
+            s.drawElectron(entityID)
+        } else if tag.Type == components.HazardZoneTag {
+            // Draw contaminated area
+            s.drawHazardZone(entityID)
+        } else if tag.Type == components.PlayerTag {
+            // Draw the shield bubble, magnet range and dash cooldown
+            s.drawPlayerEffects(entityID)
+            s.drawDashCooldown(entityID)
         }
     }
 }
@@ -205,9 +258,16 @@ func (s *RenderSystem) drawDoor(entityID components.EntityID) {
     
     collider := colliderComp.(*components.Collider)
     
-    // Determine door color based on game state
-    // For a real implementation, we would check if all requirements are met
-    doorColor := rl.Red // Default to closed
+    // Door is red while locked and pulses green once the level objectives are met
+    doorColor := rl.Red
+    unlocked := false
+    doorID, _ := s.entityManager.Registry.GetID("Door")
+    if doorComp, has := s.entityManager.GetComponent(entityID, doorID); has {
+        unlocked = doorComp.(*components.Door).Unlocked
+    }
+    if unlocked {
+        doorColor = rl.ColorAlpha(rl.Green, 0.7+0.3*float32(math.Sin(rl.GetTime()*4)))
+    }
     
     // Draw the door
     rl.DrawRectangle(
@@ -217,6 +277,385 @@ func (s *RenderSystem) drawDoor(entityID components.EntityID) {
         int32(collider.Height),
         doorColor,
     )
+    
+    // Label the exit so it can be found in larger levels
+    label := "LOCKED"
+    if unlocked {
+        label = "EXIT"
+    }
+    labelWidth := rl.MeasureText(label, 16)
+    rl.DrawText(
+        label,
+        int32(position.Value.X) - labelWidth/2,
+        int32(position.Value.Y - collider.Height/2 - 20),
+        16,
+        rl.White,
+    )
+}
+
+// drawHazardZone draws a contaminated area as a glowing pool with a pulsing edge
+func (s *RenderSystem) drawHazardZone(entityID components.EntityID) {
+    hazardZoneID, _ := s.entityManager.Registry.GetID("HazardZone")
+    zoneComp, has := s.entityManager.GetComponent(entityID, hazardZoneID)
+    if !has {
+        return
+    }
+    zone := zoneComp.(*components.HazardZone)
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    x, y := int32(position.Value.X), int32(position.Value.Y)
+    pulse := float32(math.Sin(rl.GetTime()*2))*0.5 + 0.5
+    
+    rl.DrawCircle(x, y, zone.Radius, rl.Fade(rl.Lime, 0.12+0.06*pulse))
+    rl.DrawCircleLines(x, y, zone.Radius, rl.Fade(rl.Lime, 0.5+0.4*pulse))
+    rl.DrawCircleLines(x, y, zone.Radius*(0.6+0.3*pulse), rl.Fade(rl.Lime, 0.3))
+    
+    // Radiation warning label in the middle of the zone
+    textWidth := rl.MeasureText("HAZARD", 12)
+    rl.DrawText("HAZARD", x-textWidth/2, y-6, 12, rl.Fade(rl.Yellow, 0.7))
+}
+
+// drawBossOverlay draws the boss's health bar and, while it is lining up a dash,
+// a warning line showing where it is about to go
+func (s *RenderSystem) drawBossOverlay(entityID components.EntityID) {
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    bossAIID, _ := s.entityManager.Registry.GetID("BossAI")
+    if bossComp, has := s.entityManager.GetComponent(entityID, bossAIID); has {
+        boss := bossComp.(*components.BossAI)
+        
+        if boss.Phase == components.BossTelegraph {
+            // Blink faster as the dash gets closer
+            alpha := float32(0.3)
+            if int(boss.PhaseTimer*20)%2 == 0 {
+                alpha = 0.8
+            }
+            rl.DrawLineEx(position.Value, boss.DashTarget, 3, rl.Fade(rl.Red, alpha))
+            rl.DrawCircleLines(int32(boss.DashTarget.X), int32(boss.DashTarget.Y), 25, rl.Fade(rl.Red, alpha))
+        }
+    }
+    
+    healthID, _ := s.entityManager.Registry.GetID("Health")
+    healthComp, has := s.entityManager.GetComponent(entityID, healthID)
+    if !has {
+        return
+    }
+    health := healthComp.(*components.Health)
+    
+    // Draw health bar above the boss
+    barWidth := float32(80)
+    barHeight := float32(8)
+    healthPercent := float32(health.Current) / float32(health.Max)
+    
+    rl.DrawRectangle(
+        int32(position.Value.X - barWidth/2),
+        int32(position.Value.Y - 45),
+        int32(barWidth),
+        int32(barHeight),
+        rl.DarkGray,
+    )
+    rl.DrawRectangle(
+        int32(position.Value.X - barWidth/2),
+        int32(position.Value.Y - 45),
+        int32(barWidth * healthPercent),
+        int32(barHeight),
+        rl.Red,
+    )
+}
+
+// drawAtomTier draws pulsing rings around atoms above the big atom tier, one per extra tier
+func (s *RenderSystem) drawAtomTier(entityID components.EntityID) {
+    enemyID, _ := s.entityManager.Registry.GetID("Enemy")
+    enemyComp, has := s.entityManager.GetComponent(entityID, enemyID)
+    if !has {
+        return
+    }
+    
+    prefab := components.GetAtomPrefab(enemyComp.(*components.Enemy).Type)
+    extraTiers := prefab.Tier - components.GetAtomPrefab(components.BigAtom).Tier
+    if extraTiers <= 0 {
+        return
+    }
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    pulse := float32(math.Sin(rl.GetTime()*3)) * 3
+    for i := 1; i <= extraTiers; i++ {
+        radius := prefab.Radius + float32(i)*6 + pulse
+        rl.DrawCircleLines(int32(position.Value.X), int32(position.Value.Y), radius, rl.Fade(prefab.Tint, 0.6))
+    }
+}
+
+// drawDecayWarning flashes the reach of an atom's radiation pulse shortly before it decays.
+// The flashing speeds up as the decay gets closer.
+func (s *RenderSystem) drawDecayWarning(entityID components.EntityID) {
+    enemyID, _ := s.entityManager.Registry.GetID("Enemy")
+    enemyComp, has := s.entityManager.GetComponent(entityID, enemyID)
+    if !has {
+        return
+    }
+    
+    enemy := enemyComp.(*components.Enemy)
+    if !enemy.IsAboutToDecay(constants.DecayWarningTime) {
+        return
+    }
+    
+    rule, unstable := components.GetDecayRule(enemy.Type)
+    if !unstable {
+        return
+    }
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    // 0 when the warning starts, 1 at the moment of decay
+    progress := 1 - enemy.DecayTimer/constants.DecayWarningTime
+    flash := float32(math.Sin(rl.GetTime()*float64(8+24*progress)))*0.5 + 0.5
+    
+    rl.DrawCircleLines(int32(position.Value.X), int32(position.Value.Y), rule.PulseRadius, rl.Fade(rl.Yellow, 0.2+0.5*flash))
+    rl.DrawCircle(int32(position.Value.X), int32(position.Value.Y), rule.PulseRadius*progress, rl.Fade(rl.Yellow, 0.1*flash))
+    
+    radius := components.GetAtomPrefab(enemy.Type).Radius
+    rl.DrawCircleLines(int32(position.Value.X), int32(position.Value.Y), radius+4, rl.Fade(rl.Yellow, flash))
+}
+
+// isFlickering reports whether an entity is the player, invulnerable after a hit and
+// in the hidden half of its blink. Dashing doesn't blink.
+func (s *RenderSystem) isFlickering(entityID components.EntityID) bool {
+    playerID, _ := s.entityManager.Registry.GetID("Player")
+    playerComp, has := s.entityManager.GetComponent(entityID, playerID)
+    if !has {
+        return false
+    }
+    
+    player := playerComp.(*components.Player)
+    if player.InvulnTimer <= 0 || player.IsDashing {
+        return false
+    }
+    return int(player.InvulnTimer*constants.InvulnFlickerRate)%2 == 1
+}
+
+// drawDashCooldown draws a ring under the player that fills up as the dash recharges
+func (s *RenderSystem) drawDashCooldown(entityID components.EntityID) {
+    playerID, _ := s.entityManager.Registry.GetID("Player")
+    playerComp, has := s.entityManager.GetComponent(entityID, playerID)
+    if !has {
+        return
+    }
+    player := playerComp.(*components.Player)
+    if player.IsDashing {
+        return
+    }
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    center := rl.Vector2{X: position.Value.X, Y: position.Value.Y + constants.HelicopterHeight}
+    
+    // Ready: a small solid marker
+    if player.DashCooldown <= 0 {
+        rl.DrawCircleV(center, 3, rl.Fade(rl.SkyBlue, 0.8))
+        return
+    }
+    
+    progress := 1 - player.DashCooldown/player.DashRecharge
+    rl.DrawRing(center, 5, 8, 0, 360, 24, rl.Fade(rl.DarkGray, 0.6))
+    rl.DrawRing(center, 5, 8, -90, -90+360*progress, 24, rl.SkyBlue)
+}
+
+// drawPlayerEffects draws the visible timed effects around the player
+func (s *RenderSystem) drawPlayerEffects(entityID components.EntityID) {
+    effectsID, _ := s.entityManager.Registry.GetID("ActiveEffects")
+    effectsComp, has := s.entityManager.GetComponent(entityID, effectsID)
+    if !has {
+        return
+    }
+    effects := effectsComp.(*components.ActiveEffects)
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    pulse := float32(math.Sin(rl.GetTime()*4))*0.5 + 0.5
+    
+    if effects.Has(components.EffectShield) {
+        color := components.EffectDefs[components.EffectShield].Color
+        radius := float32(constants.HelicopterWidth)*0.6 + 2*pulse
+        rl.DrawCircle(int32(position.Value.X), int32(position.Value.Y), radius, rl.Fade(color, 0.15))
+        rl.DrawCircleLines(int32(position.Value.X), int32(position.Value.Y), radius, rl.Fade(color, 0.5+0.4*pulse))
+    }
+    
+    if radius := effects.Magnitude(components.EffectMagnet); radius > 0 {
+        color := components.EffectDefs[components.EffectMagnet].Color
+        rl.DrawCircleLines(int32(position.Value.X), int32(position.Value.Y), radius, rl.Fade(color, 0.1+0.15*pulse))
+    }
+}
+
+// drawElectron draws an electron and its orbit. Knocked off electrons leave a faint
+// orbit that fills back in as they regenerate.
+func (s *RenderSystem) drawElectron(entityID components.EntityID) {
+    electronID, _ := s.entityManager.Registry.GetID("Electron")
+    electronComp, has := s.entityManager.GetComponent(entityID, electronID)
+    if !has {
+        return
+    }
+    electron := electronComp.(*components.Electron)
+    
+    parentPosComp, has := s.entityManager.GetComponent(electron.Parent, s.positionID)
+    if !has {
+        return
+    }
+    parentPos := parentPosComp.(*components.Position).Value
+    
+    if !electron.Active {
+        regen := 1 - electron.RegenTimer/constants.ElectronRegenDelay
+        rl.DrawCircleLines(int32(parentPos.X), int32(parentPos.Y), electron.OrbitRadius, rl.Fade(rl.SkyBlue, 0.1+0.2*regen))
+        return
+    }
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    rl.DrawCircleLines(int32(parentPos.X), int32(parentPos.Y), electron.OrbitRadius, rl.Fade(rl.SkyBlue, 0.35))
+    rl.DrawCircleV(position.Value, constants.ElectronRadius+2, rl.Fade(rl.SkyBlue, 0.4))
+    rl.DrawCircleV(position.Value, constants.ElectronRadius, rl.White)
+}
+
+// drawProjectile draws neutrons, and falls back to a dot with a trail for shots
+// whose sprite is missing
+func (s *RenderSystem) drawProjectile(entityID components.EntityID) {
+    factionID, _ := s.entityManager.Registry.GetID("Faction")
+    if factionComp, has := s.entityManager.GetComponent(entityID, factionID); has && factionComp.(*components.Faction).Type == components.NeutronFaction {
+        s.drawNeutron(entityID)
+        return
+    }
+    
+    // Shots with a loaded sprite are drawn with the other sprites
+    if spriteComp, has := s.entityManager.GetComponent(entityID, s.spriteID); has && spriteComp.(*components.Sprite).Texture.ID > 0 {
+        return
+    }
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    radius := float32(5)
+    colliderID, _ := s.entityManager.Registry.GetID("Collider")
+    if colliderComp, has := s.entityManager.GetComponent(entityID, colliderID); has {
+        radius = colliderComp.(*components.Collider).Radius
+    }
+    
+    color := rl.Yellow
+    projectileID, _ := s.entityManager.Registry.GetID("Projectile")
+    if projectileComp, has := s.entityManager.GetComponent(entityID, projectileID); has {
+        color = projectileComp.(*components.Projectile).Tint
+    }
+    
+    rl.DrawCircleV(position.Value, radius, color)
+    
+    // Draw a small trail behind the shot
+    velocityID, _ := s.entityManager.Registry.GetID("Velocity")
+    if velComp, has := s.entityManager.GetComponent(entityID, velocityID); has {
+        dir := rl.Vector2Normalize(velComp.(*components.Velocity).Value)
+        const trailLength = float32(15.0)
+        
+        end := rl.Vector2Subtract(position.Value, rl.Vector2Scale(dir, trailLength))
+        rl.DrawLineEx(position.Value, end, radius*0.8, rl.Fade(color, 0.6))
+    }
+}
+
+// drawNeutron draws a neutron as a small glowing dot that fades out with its lifetime
+func (s *RenderSystem) drawNeutron(entityID components.EntityID) {
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    alpha := float32(1.0)
+    lifetimeID, _ := s.entityManager.Registry.GetID("Lifetime")
+    if lifetimeComp, has := s.entityManager.GetComponent(entityID, lifetimeID); has {
+        alpha = lifetimeComp.(*components.Lifetime).Remaining / constants.NeutronLifetime
+    }
+    
+    rl.DrawCircleV(position.Value, constants.NeutronRadius*2, rl.Fade(rl.SkyBlue, 0.3*alpha))
+    rl.DrawCircleV(position.Value, constants.NeutronRadius, rl.Fade(rl.White, alpha))
+}
+
+// drawScientist draws a scientist entity as a stick figure
+func (s *RenderSystem) drawScientist(entityID components.EntityID) {
+    scientistID, _ := s.entityManager.Registry.GetID("Scientist")
+    sciComp, has := s.entityManager.GetComponent(entityID, scientistID)
+    if !has {
+        return
+    }
+    
+    scientist := sciComp.(*components.Scientist)
+    if scientist.State == components.Rescued {
+        return // Don't draw if already rescued
+    }
+    
+    posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+    position := posComp.(*components.Position)
+    
+    posX := int32(position.Value.X)
+    posY := int32(position.Value.Y)
+    
+    // Head and body color reflect the scientist's state
+    bodyColor := rl.White
+    if scientist.IsPanicking() {
+        bodyColor = rl.Yellow
+    } else if scientist.State == components.FollowingPlayer {
+        bodyColor = rl.Green // Green when following player
+    }
+    
+    // Head
+    rl.DrawCircle(posX, posY-15, 10, bodyColor)
+    
+    // Body
+    rl.DrawLine(posX, posY-5, posX, posY+15, bodyColor)
+    
+    // Legs - run cycle while panicking
+    legSwing := int32(0)
+    if scientist.IsPanicking() {
+        legSwing = int32(6 * math.Sin(float64(scientist.AnimTimer*20)))
+    }
+    rl.DrawLine(posX, posY+15, posX-8+legSwing, posY+30, bodyColor)
+    rl.DrawLine(posX, posY+15, posX+8-legSwing, posY+30, bodyColor)
+    
+    // Arms - with animation
+    if scientist.IsPanicking() {
+        // Both arms flailing above the head
+        flail := int32(4 * math.Sin(float64(scientist.AnimTimer*25)))
+        rl.DrawLine(posX, posY, posX-10, posY-12+flail, bodyColor)
+        rl.DrawLine(posX, posY, posX+10, posY-12-flail, bodyColor)
+    } else if scientist.State == components.FollowingPlayer {
+        // Waving animation for right arm when following player
+        armWaveOffset := int32(5 * math.Sin(float64(scientist.AnimTimer*10)))
+        rl.DrawLine(posX, posY, posX-10, posY+5, bodyColor)               // Left arm
+        rl.DrawLine(posX, posY, posX+10, posY-10-armWaveOffset, bodyColor) // Right arm waving
+    } else {
+        // Normal arms when wandering
+        armSwing := float32(math.Sin(float64(scientist.AnimTimer*3))) * 3
+        rl.DrawLine(posX, posY, posX-10, posY+int32(5+armSwing), bodyColor) // Left arm
+        rl.DrawLine(posX, posY, posX+10, posY+int32(5-armSwing), bodyColor) // Right arm
+    }
+    
+    // Face expression
+    eyeOffsetY := int32(-15)
+    if scientist.IsPanicking() {
+        // Wide eyes and an open mouth
+        rl.DrawCircleLines(posX-4, posY+eyeOffsetY-2, 2, rl.Black)
+        rl.DrawCircleLines(posX+4, posY+eyeOffsetY-2, 2, rl.Black)
+        rl.DrawCircle(posX, posY+eyeOffsetY+5, 3, rl.Black)
+    } else if scientist.State == components.FollowingPlayer {
+        // Happy face
+        rl.DrawCircle(posX-4, posY+eyeOffsetY-2, 2, rl.Black) // Left eye
+        rl.DrawCircle(posX+4, posY+eyeOffsetY-2, 2, rl.Black) // Right eye
+        rl.DrawCircleLines(posX, posY+eyeOffsetY+4, 4, rl.Black) // Smile
+    } else {
+        // Neutral face
+        rl.DrawCircle(posX-4, posY+eyeOffsetY-1, 2, rl.Black) // Left eye
+        rl.DrawCircle(posX+4, posY+eyeOffsetY-1, 2, rl.Black) // Right eye
+        rl.DrawLine(posX-4, posY+eyeOffsetY+4, posX+4, posY+eyeOffsetY+4, rl.Black) // Straight mouth
+    }
 }
 
 // ToggleDebugMode turns debug visualization on/off
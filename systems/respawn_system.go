@@ -0,0 +1,251 @@
+// systems/respawn_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "atomblaster/util"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// blast is one explosion in the player's death sequence
+type blast struct {
+    pos   rl.Vector2
+    delay float32 // Seconds until the blast goes off
+    age   float32 // Seconds since it went off
+}
+
+// RespawnSystem takes over when the player's health runs out: it blows up the helicopter,
+// spends a life and, after a short delay, brings the player back at a safe spot.
+// When no lives are left it hands over to the game state instead.
+type RespawnSystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    tagID         components.ComponentID
+    playerID      components.ComponentID
+    healthID      components.ComponentID
+    worldBounds   *rl.Rectangle // Pointer to the world bounds in the game state
+    lives         *int          // Pointer to the lives left in the game state
+    rng           *util.RNG
+    events        *EventBus
+    onDeath       func()               // Called before the dead player is removed
+    respawn       func(pos rl.Vector2) // Creates a new player at the given spot
+    outOfLives    func()               // Called once the last life is lost
+    dying         bool
+    timer         float32 // Seconds until the respawn
+    deathPos      rl.Vector2
+    blasts        []blast
+}
+
+// NewRespawnSystem creates a new respawn system
+func NewRespawnSystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    worldBounds *rl.Rectangle,
+    lives *int,
+    rng *util.RNG,
+    events *EventBus,
+    onDeath func(),
+    respawn func(pos rl.Vector2),
+    outOfLives func(),
+) *RespawnSystem {
+    positionID, _ := registry.GetID("Position")
+    tagID, _ := registry.GetID("Tag")
+    playerID, _ := registry.GetID("Player")
+    healthID, _ := registry.GetID("Health")
+
+    return &RespawnSystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        tagID:         tagID,
+        playerID:      playerID,
+        healthID:      healthID,
+        worldBounds:   worldBounds,
+        lives:         lives,
+        rng:           rng,
+        events:        events,
+        onDeath:       onDeath,
+        respawn:       respawn,
+        outOfLives:    outOfLives,
+    }
+}
+
+// Reset forgets any death in progress, e.g. when a level is reloaded
+func (s *RespawnSystem) Reset() {
+    s.dying = false
+    s.timer = 0
+    s.blasts = nil
+}
+
+// IsRespawning reports whether the player is dead and waiting to come back
+func (s *RespawnSystem) IsRespawning() bool {
+    return s.dying
+}
+
+// Update watches the player's health and runs the death sequence and respawn
+func (s *RespawnSystem) Update(dt float32) {
+    for i := range s.blasts {
+        if s.blasts[i].delay > 0 {
+            s.blasts[i].delay -= dt
+        } else {
+            s.blasts[i].age += dt
+        }
+    }
+
+    if !s.dying {
+        s.checkDeath()
+        return
+    }
+
+    s.timer -= dt
+    if s.timer > 0 {
+        return
+    }
+
+    s.dying = false
+    s.blasts = nil
+
+    if *s.lives <= 0 {
+        if s.outOfLives != nil {
+            s.outOfLives()
+        }
+        return
+    }
+
+    if s.respawn != nil {
+        s.respawn(s.safeSpawnPoint())
+    }
+}
+
+// checkDeath starts the death sequence once the player has no health left
+func (s *RespawnSystem) checkDeath() {
+    playerEntities := s.entityManager.GetEntitiesWithComponents(s.playerID, s.healthID, s.positionID)
+    if len(playerEntities) == 0 {
+        return
+    }
+
+    playerEntity := playerEntities[0]
+    healthComp, _ := s.entityManager.GetComponent(playerEntity, s.healthID)
+    if healthComp.(*components.Health).Current > 0 {
+        return
+    }
+
+    posComp, _ := s.entityManager.GetComponent(playerEntity, s.positionID)
+    s.deathPos = posComp.(*components.Position).Value
+
+    *s.lives--
+    s.dying = true
+    s.timer = constants.RespawnDelay
+    s.startExplosion(s.deathPos)
+
+    if s.onDeath != nil {
+        s.onDeath()
+    }
+    s.entityManager.DestroyEntity(playerEntity)
+
+    s.events.Publish(Event{Type: EventPlayerDied, Entity: playerEntity, Position: s.deathPos, Value: *s.lives})
+}
+
+// startExplosion queues a chain of blasts scattered around the wreck
+func (s *RespawnSystem) startExplosion(pos rl.Vector2) {
+    s.blasts = make([]blast, 0, constants.DeathExplosionBlasts)
+    for i := 0; i < constants.DeathExplosionBlasts; i++ {
+        offset := rl.Vector2{}
+        if i > 0 {
+            angle := s.rng.Range(0, 2*math.Pi)
+            dist := s.rng.Range(0, constants.DeathExplosionSpread)
+            offset = rl.Vector2{X: float32(math.Cos(float64(angle))) * dist, Y: float32(math.Sin(float64(angle))) * dist}
+        }
+        s.blasts = append(s.blasts, blast{
+            pos:   rl.Vector2Add(pos, offset),
+            delay: float32(i) * constants.DeathExplosionInterval,
+        })
+    }
+}
+
+// safeSpawnPoint picks where the player comes back: where they died if it is clear,
+// then the level's starting spot, then random spots, falling back to the spot with
+// the most room if nowhere is completely clear
+func (s *RespawnSystem) safeSpawnPoint() rl.Vector2 {
+    bounds := *s.worldBounds
+    margin := float32(constants.HelicopterWidth)
+
+    candidates := []rl.Vector2{
+        s.clampToWorld(s.deathPos, margin),
+        {X: bounds.X + bounds.Width/4, Y: bounds.Y + bounds.Height/2},
+    }
+    for i := 0; i < constants.RespawnCandidates; i++ {
+        candidates = append(candidates, rl.Vector2{
+            X: s.rng.Range(bounds.X+margin, bounds.X+bounds.Width-margin),
+            Y: s.rng.Range(bounds.Y+margin, bounds.Y+bounds.Height-margin),
+        })
+    }
+
+    best := candidates[0]
+    bestClearance := float32(-1)
+    for _, candidate := range candidates {
+        clearance := s.clearance(candidate)
+        if clearance >= constants.RespawnSafeRadius {
+            return candidate
+        }
+        if clearance > bestClearance {
+            best = candidate
+            bestClearance = clearance
+        }
+    }
+
+    return best
+}
+
+// clearance returns the distance from a position to the nearest atom or boss
+func (s *RespawnSystem) clearance(pos rl.Vector2) float32 {
+    nearest := float32(math.MaxFloat32)
+
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.tagID, s.positionID) {
+        tagComp, _ := s.entityManager.GetComponent(entityID, s.tagID)
+        tagType := tagComp.(*components.Tag).Type
+        if tagType != components.EnemyTag && tagType != components.BossTag {
+            continue
+        }
+
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        if dist := rl.Vector2Distance(pos, posComp.(*components.Position).Value); dist < nearest {
+            nearest = dist
+        }
+    }
+
+    return nearest
+}
+
+// clampToWorld keeps a position at least margin away from the edges of the world
+func (s *RespawnSystem) clampToWorld(pos rl.Vector2, margin float32) rl.Vector2 {
+    bounds := *s.worldBounds
+    return rl.Vector2{
+        X: util.ClampValue(pos.X, bounds.X+margin, bounds.X+bounds.Width-margin),
+        Y: util.ClampValue(pos.Y, bounds.Y+margin, bounds.Y+bounds.Height-margin),
+    }
+}
+
+// Draw renders the death explosion in world coordinates, so it should be
+// called between Camera.Begin and Camera.End
+func (s *RespawnSystem) Draw() {
+    for _, b := range s.blasts {
+        if b.delay > 0 || b.age > constants.DeathBlastDuration {
+            continue
+        }
+
+        progress := b.age / constants.DeathBlastDuration
+        radius := constants.DeathBlastRadius * float32(math.Sqrt(float64(progress)))
+        alpha := 1 - progress
+
+        rl.DrawCircleV(b.pos, radius, rl.Fade(rl.Orange, 0.6*alpha))
+        rl.DrawCircleV(b.pos, radius*0.6, rl.Fade(rl.Yellow, 0.8*alpha))
+        rl.DrawCircleLines(int32(b.pos.X), int32(b.pos.Y), radius*1.2, rl.Fade(rl.Red, alpha))
+    }
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *RespawnSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.playerID, s.healthID}
+}
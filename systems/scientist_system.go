@@ -0,0 +1,255 @@
+// systems/scientist_system.go
+package systems
+
+import (
+    "atomblaster/components"
+    "atomblaster/constants"
+    "atomblaster/util"
+    "math"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// ScientistSystem drives scientist behavior: wandering, following the player and panicking near atoms
+type ScientistSystem struct {
+    entityManager *components.EntityManager
+    positionID    components.ComponentID
+    velocityID    components.ComponentID
+    scientistID   components.ComponentID
+    playerID      components.ComponentID
+    enemyID       components.ComponentID
+    worldBounds   *rl.Rectangle // Pointer to the world bounds in the game state
+    rng           *util.RNG
+    events        *EventBus
+}
+
+// NewScientistSystem creates a new scientist system
+func NewScientistSystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    worldBounds *rl.Rectangle,
+    rng *util.RNG,
+    events *EventBus,
+) *ScientistSystem {
+    positionID, _ := registry.GetID("Position")
+    velocityID, _ := registry.GetID("Velocity")
+    scientistID, _ := registry.GetID("Scientist")
+    playerID, _ := registry.GetID("Player")
+    enemyID, _ := registry.GetID("Enemy")
+
+    return &ScientistSystem{
+        entityManager: entityManager,
+        positionID:    positionID,
+        velocityID:    velocityID,
+        scientistID:   scientistID,
+        playerID:      playerID,
+        enemyID:       enemyID,
+        worldBounds:   worldBounds,
+        rng:           rng,
+        events:        events,
+    }
+}
+
+// Update sets the velocity of every scientist based on its current state
+func (s *ScientistSystem) Update(dt float32) {
+    // Find the player
+    var playerPos rl.Vector2
+    var playerVel rl.Vector2
+    hasPlayer := false
+
+    playerEntities := s.entityManager.GetEntitiesWithComponents(s.playerID, s.positionID, s.velocityID)
+    if len(playerEntities) > 0 {
+        posComp, _ := s.entityManager.GetComponent(playerEntities[0], s.positionID)
+        velComp, _ := s.entityManager.GetComponent(playerEntities[0], s.velocityID)
+        playerPos = posComp.(*components.Position).Value
+        playerVel = velComp.(*components.Velocity).Value
+        hasPlayer = true
+    }
+
+    // Gather atom positions once for the panic checks
+    atomPositions := make([]rl.Vector2, 0)
+    for _, entityID := range s.entityManager.GetEntitiesWithComponents(s.enemyID, s.positionID) {
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        atomPositions = append(atomPositions, posComp.(*components.Position).Value)
+    }
+
+    scientists := s.entityManager.GetEntitiesWithComponents(s.scientistID, s.positionID, s.velocityID)
+
+    // Collect follower positions so followers can keep their distance from each other
+    followerPositions := make(map[components.EntityID]rl.Vector2)
+    for _, entityID := range scientists {
+        sciComp, _ := s.entityManager.GetComponent(entityID, s.scientistID)
+        if sciComp.(*components.Scientist).State == components.FollowingPlayer {
+            posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+            followerPositions[entityID] = posComp.(*components.Position).Value
+        }
+    }
+
+    for _, entityID := range scientists {
+        sciComp, _ := s.entityManager.GetComponent(entityID, s.scientistID)
+        posComp, _ := s.entityManager.GetComponent(entityID, s.positionID)
+        velComp, _ := s.entityManager.GetComponent(entityID, s.velocityID)
+
+        scientist := sciComp.(*components.Scientist)
+        position := posComp.(*components.Position)
+        velocity := velComp.(*components.Velocity)
+
+        scientist.AnimTimer += dt
+
+        if scientist.State == components.Rescued {
+            velocity.Value = rl.Vector2{X: 0, Y: 0}
+            continue
+        }
+
+        // Check for nearby atoms; a scare restarts the panic timer
+        s.checkForThreats(scientist, position.Value, atomPositions)
+
+        if scientist.IsPanicking() {
+            scientist.PanicTimer -= dt
+            velocity.Value = rl.Vector2Scale(scientist.FleeDir, constants.ScientistFleeSpeed)
+        } else if scientist.State == components.FollowingPlayer && hasPlayer {
+            s.updateFollowing(entityID, scientist, position, velocity, playerPos, playerVel, followerPositions, dt)
+        } else {
+            s.updateWandering(scientist, position, velocity, dt)
+
+            // Pick up the scientist when the player flies close enough
+            if hasPlayer && rl.Vector2Distance(position.Value, playerPos) < constants.ScientistPickupRadius {
+                scientist.State = components.FollowingPlayer
+                s.events.Publish(Event{Type: EventScientistPickedUp, Entity: entityID, Position: position.Value})
+            }
+        }
+
+        s.keepInsideWorld(scientist, position, velocity)
+    }
+}
+
+// checkForThreats makes the scientist panic and run from the closest atom in range
+func (s *ScientistSystem) checkForThreats(scientist *components.Scientist, pos rl.Vector2, atomPositions []rl.Vector2) {
+    closestDist := float32(constants.ScientistPanicRadius)
+    var closest *rl.Vector2
+
+    for i := range atomPositions {
+        dist := rl.Vector2Distance(pos, atomPositions[i])
+        if dist < closestDist {
+            closestDist = dist
+            closest = &atomPositions[i]
+        }
+    }
+
+    if closest == nil {
+        return
+    }
+
+    away := rl.Vector2Subtract(pos, *closest)
+    if rl.Vector2Length(away) == 0 {
+        away = rl.Vector2{X: 1, Y: 0}
+    }
+
+    scientist.FleeDir = rl.Vector2Normalize(away)
+    scientist.PanicTimer = constants.ScientistPanicDuration
+}
+
+// updateWandering moves the scientist slowly in a random direction that changes every few seconds
+func (s *ScientistSystem) updateWandering(scientist *components.Scientist, position *components.Position, velocity *components.Velocity, dt float32) {
+    scientist.WanderTimer -= dt
+    if scientist.WanderTimer <= 0 {
+        scientist.WanderTimer = 1.0 + s.rng.Range(0, 2)
+
+        angle := float64(s.rng.Range(0, 2*math.Pi))
+        scientist.WanderDir = rl.Vector2{
+            X: float32(math.Cos(angle)),
+            Y: float32(math.Sin(angle)),
+        }
+    }
+
+    velocity.Value = rl.Vector2Scale(scientist.WanderDir, constants.ScientistWanderSpeed)
+}
+
+// updateFollowing steers a follower toward its spot behind the player, easing in as it
+// arrives and pushing away from other followers so they don't stack on top of each other
+func (s *ScientistSystem) updateFollowing(
+    entityID components.EntityID,
+    scientist *components.Scientist,
+    position *components.Position,
+    velocity *components.Velocity,
+    playerPos rl.Vector2,
+    playerVel rl.Vector2,
+    followerPositions map[components.EntityID]rl.Vector2,
+    dt float32,
+) {
+    // Trail behind the direction the player is flying
+    behind := rl.Vector2{X: -1, Y: 0}
+    if rl.Vector2Length(playerVel) > 1 {
+        behind = rl.Vector2Negate(rl.Vector2Normalize(playerVel))
+    }
+
+    target := rl.Vector2Add(playerPos, rl.Vector2Scale(behind, constants.ScientistFollowDistance))
+    target = rl.Vector2Add(target, scientist.FollowOffset)
+
+    // Arrive behavior: full speed when far away, slowing as the target gets close
+    toTarget := rl.Vector2Subtract(target, position.Value)
+    dist := rl.Vector2Length(toTarget)
+
+    desired := rl.Vector2{X: 0, Y: 0}
+    if dist > 1 {
+        speed := dist * 4
+        if speed > constants.ScientistFollowSpeed {
+            speed = constants.ScientistFollowSpeed
+        }
+        desired = rl.Vector2Scale(rl.Vector2Normalize(toTarget), speed)
+    }
+
+    // Separation from other followers
+    for otherID, otherPos := range followerPositions {
+        if otherID == entityID {
+            continue
+        }
+
+        away := rl.Vector2Subtract(position.Value, otherPos)
+        otherDist := rl.Vector2Length(away)
+        if otherDist > 0 && otherDist < constants.ScientistSeparation {
+            push := (constants.ScientistSeparation - otherDist) / constants.ScientistSeparation
+            desired = rl.Vector2Add(desired, rl.Vector2Scale(rl.Vector2Normalize(away), push*constants.ScientistFollowSpeed*0.5))
+        }
+    }
+
+    // Blend toward the desired velocity so followers move smoothly
+    blend := 1 - float32(math.Exp(float64(-8*dt)))
+    velocity.Value = rl.Vector2Lerp(velocity.Value, desired, blend)
+}
+
+// keepInsideWorld turns wandering scientists around before they reach the edge of the world
+func (s *ScientistSystem) keepInsideWorld(scientist *components.Scientist, position *components.Position, velocity *components.Velocity) {
+    bounds := *s.worldBounds
+    margin := float32(20)
+
+    if position.Value.X < bounds.X+margin && velocity.Value.X < 0 {
+        scientist.WanderDir.X = float32(math.Abs(float64(scientist.WanderDir.X)))
+        scientist.FleeDir.X = float32(math.Abs(float64(scientist.FleeDir.X)))
+        velocity.Value.X = 0
+    }
+    if position.Value.X > bounds.X+bounds.Width-margin && velocity.Value.X > 0 {
+        scientist.WanderDir.X = -float32(math.Abs(float64(scientist.WanderDir.X)))
+        scientist.FleeDir.X = -float32(math.Abs(float64(scientist.FleeDir.X)))
+        velocity.Value.X = 0
+    }
+    if position.Value.Y < bounds.Y+margin && velocity.Value.Y < 0 {
+        scientist.WanderDir.Y = float32(math.Abs(float64(scientist.WanderDir.Y)))
+        scientist.FleeDir.Y = float32(math.Abs(float64(scientist.FleeDir.Y)))
+        velocity.Value.Y = 0
+    }
+    if position.Value.Y > bounds.Y+bounds.Height-margin && velocity.Value.Y > 0 {
+        scientist.WanderDir.Y = -float32(math.Abs(float64(scientist.WanderDir.Y)))
+        scientist.FleeDir.Y = -float32(math.Abs(float64(scientist.FleeDir.Y)))
+        velocity.Value.Y = 0
+    }
+}
+
+// Draw is empty for ScientistSystem; scientists are drawn by the RenderSystem
+func (s *ScientistSystem) Draw() {
+    // Scientist system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *ScientistSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{s.scientistID, s.positionID, s.velocityID}
+}
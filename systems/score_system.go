@@ -0,0 +1,213 @@
+// systems/score_system.go
+package systems
+
+import (
+    "atomblaster/components"
+)
+
+// ScoreSystem turns gameplay events into points. Kills build a combo that raises a
+// multiplier while they keep coming, and clearing a level pays out bonuses for taking
+// no damage, beating the par time and rescuing scientists.
+type ScoreSystem struct {
+    entityManager  *components.EntityManager
+    playerID       components.ComponentID
+    effectsID      components.ComponentID
+    score          *int           // Pointer to the score value in the game state
+    combo          int            // Kills in the current combo
+    comboTimer     float32        // Seconds left for the next kill to extend the combo
+    levelTime      float32        // Seconds spent on the current level
+    parTime        float32        // Par time of the current level
+    damaged        bool           // Whether the player took damage on the current level
+    rescued        int            // Scientists rescued on the current level
+    Breakdown      ScoreBreakdown // Where the run's score came from
+    LevelBreakdown ScoreBreakdown // Where the current level's score came from
+}
+
+// NewScoreSystem creates a new score system
+func NewScoreSystem(
+    entityManager *components.EntityManager,
+    registry *components.ComponentTypeRegistry,
+    score *int,
+    events *EventBus,
+) *ScoreSystem {
+    playerID, _ := registry.GetID("Player")
+    effectsID, _ := registry.GetID("ActiveEffects")
+
+    s := &ScoreSystem{
+        entityManager: entityManager,
+        playerID:      playerID,
+        effectsID:     effectsID,
+        score:         score,
+    }
+
+    if events != nil {
+        events.Subscribe(EventEnemyKilled, s.handleEnemyKilled)
+        events.Subscribe(EventEnemyHit, s.handleEnemyHit)
+        events.Subscribe(EventChainCleared, s.handleChainCleared)
+        events.Subscribe(EventPowerUpCollected, s.handlePowerUpCollected)
+        events.Subscribe(EventScientistRescued, s.handleScientistRescued)
+        events.Subscribe(EventPlayerDamaged, s.handlePlayerHurt)
+        events.Subscribe(EventPlayerDied, s.handlePlayerHurt)
+        events.Subscribe(EventLevelCompleted, s.handleLevelCompleted)
+    }
+
+    return s
+}
+
+// Reset clears the breakdown for a new run
+func (s *ScoreSystem) Reset() {
+    s.Breakdown = ScoreBreakdown{}
+}
+
+// Restore goes back to a breakdown saved at a checkpoint, taking a continue penalty off
+func (s *ScoreSystem) Restore(breakdown ScoreBreakdown, penalty int) {
+    s.Breakdown = breakdown
+    s.Breakdown.Penalty -= penalty
+}
+
+// StartLevel starts tracking a new level with the given par time
+func (s *ScoreSystem) StartLevel(parTime float32) {
+    s.combo = 0
+    s.comboTimer = 0
+    s.levelTime = 0
+    s.parTime = parTime
+    s.damaged = false
+    s.rescued = 0
+    s.LevelBreakdown = ScoreBreakdown{}
+}
+
+// Update counts the level time and lets the combo run out
+func (s *ScoreSystem) Update(dt float32) {
+    s.levelTime += dt
+
+    if s.comboTimer > 0 {
+        s.comboTimer -= dt
+        if s.comboTimer <= 0 {
+            s.combo = 0
+        }
+    }
+}
+
+// ComboMultiplier returns the multiplier the current combo gives kill points
+func (s *ScoreSystem) ComboMultiplier() float32 {
+    tuning := ScoreValues
+    if tuning.ComboStep <= 0 {
+        return 1
+    }
+
+    multiplier := 1 + float32(s.combo/tuning.ComboStep)*tuning.ComboMultiplierStep
+    if multiplier > tuning.MaxComboMultiplier {
+        multiplier = tuning.MaxComboMultiplier
+    }
+    return multiplier
+}
+
+// handleEnemyKilled extends the combo; destroyed bosses also score right away.
+// Atoms score through their fission chain once the whole chain is gone.
+func (s *ScoreSystem) handleEnemyKilled(e Event) {
+    s.combo++
+    s.comboTimer = ScoreValues.ComboWindow
+
+    if components.EnemyType(e.Value) == components.Boss {
+        s.award(ScoreValues.BossKill, true, func(b *ScoreBreakdown, points int) { b.Kills += points })
+    }
+}
+
+// handleEnemyHit scores damage that didn't destroy the target
+func (s *ScoreSystem) handleEnemyHit(e Event) {
+    s.award(ScoreValues.EnemyHit, true, func(b *ScoreBreakdown, points int) { b.Kills += points })
+}
+
+// handleChainCleared pays out the points banked by a fission chain
+func (s *ScoreSystem) handleChainCleared(e Event) {
+    s.award(e.Value, true, func(b *ScoreBreakdown, points int) { b.Kills += points })
+}
+
+// handlePowerUpCollected scores a collected power-up
+func (s *ScoreSystem) handlePowerUpCollected(e Event) {
+    points := ScoreValues.PowerUps[components.PowerUpType(e.Value)]
+    s.award(points, false, func(b *ScoreBreakdown, points int) { b.PowerUps += points })
+}
+
+// handleScientistRescued scores a rescue and counts it toward the level's rescue bonus
+func (s *ScoreSystem) handleScientistRescued(e Event) {
+    s.rescued++
+    s.award(ScoreValues.ScientistRescued, false, func(b *ScoreBreakdown, points int) { b.Rescues += points })
+}
+
+// handlePlayerHurt breaks the combo and loses the no-damage bonus
+func (s *ScoreSystem) handlePlayerHurt(e Event) {
+    s.damaged = true
+    s.combo = 0
+    s.comboTimer = 0
+}
+
+// handleLevelCompleted pays out the end-of-level bonuses
+func (s *ScoreSystem) handleLevelCompleted(e Event) {
+    tuning := ScoreValues
+
+    if !s.damaged {
+        s.bonus(tuning.NoDamageBonus, func(b *ScoreBreakdown, points int) { b.NoDamage += points })
+    }
+
+    if s.parTime > 0 && s.levelTime < s.parTime {
+        secondsUnder := int(s.parTime - s.levelTime)
+        s.bonus(secondsUnder*tuning.TimeBonusPerSecond, func(b *ScoreBreakdown, points int) { b.Time += points })
+    }
+
+    s.bonus(s.rescued*tuning.RescueBonus, func(b *ScoreBreakdown, points int) { b.RescueBonus += points })
+}
+
+// award adds points earned during play. Kill points are raised by the combo, and
+// everything is doubled while the player has a double score effect. The record
+// function files the base points under their category in a breakdown.
+func (s *ScoreSystem) award(points int, combo bool, record func(b *ScoreBreakdown, points int)) {
+    if points <= 0 {
+        return
+    }
+
+    withCombo := points
+    if combo {
+        withCombo = int(float32(points) * s.ComboMultiplier())
+    }
+    total := int(float32(withCombo) * s.doubleScore())
+
+    for _, b := range []*ScoreBreakdown{&s.Breakdown, &s.LevelBreakdown} {
+        record(b, points)
+        b.Combo += withCombo - points
+        b.DoubleScore += total - withCombo
+    }
+    *s.score += total
+}
+
+// bonus adds end-of-level points, which no multiplier applies to
+func (s *ScoreSystem) bonus(points int, record func(b *ScoreBreakdown, points int)) {
+    if points <= 0 {
+        return
+    }
+
+    record(&s.Breakdown, points)
+    record(&s.LevelBreakdown, points)
+    *s.score += points
+}
+
+// doubleScore returns the player's double score multiplier, or 1 without the effect
+func (s *ScoreSystem) doubleScore() float32 {
+    for _, playerEntity := range s.entityManager.GetEntitiesWithComponents(s.playerID, s.effectsID) {
+        effectsComp, _ := s.entityManager.GetComponent(playerEntity, s.effectsID)
+        if multiplier := effectsComp.(*components.ActiveEffects).Magnitude(components.EffectDoubleScore); multiplier > 1 {
+            return multiplier
+        }
+    }
+    return 1
+}
+
+// Draw is empty for ScoreSystem; the score is drawn by the HUD
+func (s *ScoreSystem) Draw() {
+    // Score system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *ScoreSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{}
+}
@@ -0,0 +1,66 @@
+// systems/score_table.go
+package systems
+
+import (
+    "atomblaster/components"
+)
+
+// ScoreTuning holds every point value and scoring rule, so scoring can be balanced in one place
+type ScoreTuning struct {
+    FissionAtom          int                            // Points banked for each atom destroyed in a fission chain
+    MaxCascadeMultiplier int                            // Cap on the chain reaction multiplier for banked points
+    EnemyHit             int                            // Damaging an atom or boss without destroying it
+    BossKill             int                            // Destroying a boss
+    ScientistRescued     int                            // Bringing a scientist to the rescue zone
+    PowerUps             map[components.PowerUpType]int // Collecting each kind of power-up
+    ComboWindow          float32                        // Seconds after a kill in which the next kill extends the combo
+    ComboStep            int                            // Kills in a combo per multiplier step
+    ComboMultiplierStep  float32                        // Multiplier added per step
+    MaxComboMultiplier   float32                        // Cap on the combo multiplier
+    NoDamageBonus        int                            // Clearing a level without taking damage
+    TimeBonusPerSecond   int                            // Each second a level is cleared under its par time
+    RescueBonus          int                            // Each scientist rescued, awarded when the level is cleared
+}
+
+// ScoreValues is the scoring used by the game
+var ScoreValues = ScoreTuning{
+    FissionAtom:          10,
+    MaxCascadeMultiplier: 5,
+    EnemyHit:             5,
+    BossKill:             2000,
+    ScientistRescued:     100,
+    PowerUps: map[components.PowerUpType]int{
+        components.PowerUpWeapon:      25,
+        components.PowerUpHealth:      15,
+        components.PowerUpSpeed:       20,
+        components.PowerUpRapidFire:   20,
+        components.PowerUpShield:      20,
+        components.PowerUpMagnet:      20,
+        components.PowerUpDoubleScore: 20,
+    },
+    ComboWindow:         2.0,
+    ComboStep:           3,
+    ComboMultiplierStep: 0.5,
+    MaxComboMultiplier:  4,
+    NoDamageBonus:       500,
+    TimeBonusPerSecond:  10,
+    RescueBonus:         50,
+}
+
+// ScoreBreakdown adds up where a score came from
+type ScoreBreakdown struct {
+    Kills       int // Atoms and bosses damaged and destroyed
+    Combo       int // Extra points from the combo multiplier
+    DoubleScore int // Extra points from the double score effect
+    PowerUps    int
+    Rescues     int
+    NoDamage    int // No-damage bonuses
+    Time        int // Time bonuses
+    RescueBonus int // End-of-level rescue bonuses
+    Penalty     int // Points lost to continues, as a negative number
+}
+
+// Total returns the score the breakdown adds up to
+func (b ScoreBreakdown) Total() int {
+    return b.Kills + b.Combo + b.DoubleScore + b.PowerUps + b.Rescues + b.NoDamage + b.Time + b.RescueBonus + b.Penalty
+}
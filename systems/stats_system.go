@@ -0,0 +1,149 @@
+// systems/stats_system.go
+package systems
+
+import (
+    "atomblaster/components"
+)
+
+// enemyNames labels enemy types in the run statistics
+var enemyNames = map[components.EnemyType]string{
+    components.NormalAtom:     "Normal Atom",
+    components.FastAtom:       "Fast Atom",
+    components.BigAtom:        "Big Atom",
+    components.Boss:           "Boss",
+    components.HeavyAtom:      "Heavy Atom",
+    components.SuperheavyAtom: "Superheavy Atom",
+}
+
+// powerUpNames labels power-up types in the run statistics
+var powerUpNames = map[components.PowerUpType]string{
+    components.PowerUpWeapon:      "Weapon",
+    components.PowerUpHealth:      "Health",
+    components.PowerUpSpeed:       "Speed",
+    components.PowerUpRapidFire:   "Rapid Fire",
+    components.PowerUpShield:      "Shield",
+    components.PowerUpMagnet:      "Magnet",
+    components.PowerUpDoubleScore: "Double Score",
+}
+
+// LevelTime is the time spent on one attempt at a level
+type LevelTime struct {
+    Level     int     `json:"level"`
+    Seconds   float32 `json:"seconds"`
+    Completed bool    `json:"completed"` // False for attempts that ended in a game over
+}
+
+// RunStats records how a run went, for the results screen and for comparing balance changes
+type RunStats struct {
+    ShotsFired  int            `json:"shots_fired"`
+    ShotsHit    int            `json:"shots_hit"`
+    Kills       map[string]int `json:"kills"` // Enemies destroyed, by type
+    DamageTaken int            `json:"damage_taken"`
+    Deaths      int            `json:"deaths"`
+    Dashes      int            `json:"dashes"`
+    PowerUps    map[string]int `json:"power_ups"` // Power-ups collected, by type
+    LevelTimes  []LevelTime    `json:"level_times"`
+}
+
+// Accuracy returns the fraction of shots that hit something (0 to 1)
+func (r RunStats) Accuracy() float32 {
+    if r.ShotsFired == 0 {
+        return 0
+    }
+    return float32(r.ShotsHit) / float32(r.ShotsFired)
+}
+
+// TotalKills returns the number of enemies destroyed
+func (r RunStats) TotalKills() int {
+    total := 0
+    for _, count := range r.Kills {
+        total += count
+    }
+    return total
+}
+
+// TotalPowerUps returns the number of power-ups collected
+func (r RunStats) TotalPowerUps() int {
+    total := 0
+    for _, count := range r.PowerUps {
+        total += count
+    }
+    return total
+}
+
+// StatsSystem records RunStats from gameplay events
+type StatsSystem struct {
+    Stats     RunStats
+    level     int     // Level being timed (0 = none)
+    levelTime float32 // Seconds spent on the current attempt
+}
+
+// NewStatsSystem creates a new stats system
+func NewStatsSystem(events *EventBus) *StatsSystem {
+    s := &StatsSystem{}
+    s.Reset()
+
+    if events != nil {
+        events.Subscribe(EventShotFired, func(e Event) { s.Stats.ShotsFired += e.Value })
+        events.Subscribe(EventShotHit, func(e Event) { s.Stats.ShotsHit++ })
+        events.Subscribe(EventEnemyKilled, func(e Event) { s.Stats.Kills[enemyNames[components.EnemyType(e.Value)]]++ })
+        events.Subscribe(EventPlayerDamaged, func(e Event) { s.Stats.DamageTaken += e.Value })
+        events.Subscribe(EventPlayerDied, func(e Event) { s.Stats.Deaths++ })
+        events.Subscribe(EventDashed, func(e Event) { s.Stats.Dashes++ })
+        events.Subscribe(EventPowerUpCollected, func(e Event) { s.Stats.PowerUps[powerUpNames[components.PowerUpType(e.Value)]]++ })
+        events.Subscribe(EventLevelCompleted, s.handleLevelCompleted)
+    }
+
+    return s
+}
+
+// Reset clears the stats for a new run
+func (s *StatsSystem) Reset() {
+    s.Stats = RunStats{
+        Kills:    make(map[string]int),
+        PowerUps: make(map[string]int),
+    }
+    s.level = 0
+    s.levelTime = 0
+}
+
+// StartLevel starts timing an attempt at a level. An attempt still running, such as
+// one the player is continuing after a game over, is recorded as not completed.
+func (s *StatsSystem) StartLevel(level int) {
+    s.Finish()
+    s.level = level
+    s.levelTime = 0
+}
+
+// Finish records the attempt in progress when the run ends
+func (s *StatsSystem) Finish() {
+    if s.level > 0 && s.levelTime > 0 {
+        s.Stats.LevelTimes = append(s.Stats.LevelTimes, LevelTime{Level: s.level, Seconds: s.levelTime})
+    }
+    s.level = 0
+    s.levelTime = 0
+}
+
+// handleLevelCompleted records the time the level took
+func (s *StatsSystem) handleLevelCompleted(e Event) {
+    s.Stats.LevelTimes = append(s.Stats.LevelTimes, LevelTime{Level: e.Value, Seconds: s.levelTime, Completed: true})
+    s.level = 0
+    s.levelTime = 0
+}
+
+// Update times the current level
+func (s *StatsSystem) Update(dt float32) {
+    if s.level > 0 {
+        s.levelTime += dt
+    }
+}
+
+// Draw is empty for StatsSystem; the stats are drawn by the results screen
+func (s *StatsSystem) Draw() {
+    // Stats system doesn't need to draw anything
+}
+
+// RequiredComponents returns the component types this system operates on
+func (s *StatsSystem) RequiredComponents() []components.ComponentID {
+    return []components.ComponentID{}
+}
@@ -0,0 +1,39 @@
+// ui/controllers/achievements_controller.go
+package controllers
+
+import (
+    "atomblaster/constants"
+    "atomblaster/ui"
+    "atomblaster/ui/models"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AchievementsController handles input for the achievements gallery
+type AchievementsController struct {
+    model        *models.AchievementsModel
+    currentState *int
+}
+
+// NewAchievementsController creates a new achievements gallery controller
+func NewAchievementsController(model *models.AchievementsModel, currentState *int) *AchievementsController {
+    return &AchievementsController{
+        model:        model,
+        currentState: currentState,
+    }
+}
+
+// SetModel sets the controller's data model
+func (c *AchievementsController) SetModel(model ui.Model) {
+    c.model = model.(*models.AchievementsModel)
+}
+
+// HandleInput processes input for the achievements gallery
+func (c *AchievementsController) HandleInput() bool {
+    // Return to the title menu
+    if rl.IsKeyPressed(rl.KeyEscape) || rl.IsKeyPressed(rl.KeyBackspace) || rl.IsKeyPressed(rl.KeyEnter) || rl.IsKeyPressed(rl.KeySpace) {
+        *c.currentState = constants.StateTitle
+        return true
+    }
+    
+    return false
+}
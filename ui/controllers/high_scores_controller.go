@@ -0,0 +1,39 @@
+// ui/controllers/high_scores_controller.go
+package controllers
+
+import (
+    "atomblaster/constants"
+    "atomblaster/ui"
+    "atomblaster/ui/models"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// HighScoresController handles input for the high scores screen
+type HighScoresController struct {
+    model        *models.HighScoresModel
+    currentState *int
+}
+
+// NewHighScoresController creates a new high scores screen controller
+func NewHighScoresController(model *models.HighScoresModel, currentState *int) *HighScoresController {
+    return &HighScoresController{
+        model:        model,
+        currentState: currentState,
+    }
+}
+
+// SetModel sets the controller's data model
+func (c *HighScoresController) SetModel(model ui.Model) {
+    c.model = model.(*models.HighScoresModel)
+}
+
+// HandleInput processes input for the high scores screen
+func (c *HighScoresController) HandleInput() bool {
+    // Return to the title menu
+    if rl.IsKeyPressed(rl.KeyEscape) || rl.IsKeyPressed(rl.KeyEnter) || rl.IsKeyPressed(rl.KeySpace) {
+        *c.currentState = constants.StateTitle
+        return true
+    }
+    
+    return false
+}
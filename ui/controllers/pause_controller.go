@@ -76,6 +76,7 @@ import (
     "atomblaster/constants"
     "atomblaster/ui"
     "atomblaster/ui/models"
+    "strings"
     rl "github.com/gen2brain/raylib-go/raylib"
 )
 
@@ -84,14 +85,23 @@ type GameOverController struct {
     model        *models.GameOverModel
     currentState *int
     resetGame    func()
+    continueGame func()
+    submitScore  func(name string) int // Records the run on the leaderboard and returns its rank
 }
 
 // NewGameOverController creates a new game over screen controller
-func NewGameOverController(model *models.GameOverModel, currentState *int, resetGame func()) *GameOverController {
+func NewGameOverController(
+    model *models.GameOverModel,
+    currentState *int,
+    resetGame, continueGame func(),
+    submitScore func(name string) int,
+) *GameOverController {
     return &GameOverController{
         model:        model,
         currentState: currentState,
         resetGame:    resetGame,
+        continueGame: continueGame,
+        submitScore:  submitScore,
     }
 }
 
@@ -102,6 +112,24 @@ func (c *GameOverController) SetModel(model ui.Model) {
 
 // HandleInput processes input for the game over screen
 func (c *GameOverController) HandleInput() bool {
+    // A qualifying score asks for a name before anything else
+    if c.model.EnteringName {
+        c.handleNameEntry()
+        return false
+    }
+    
+    // Switch between the score breakdown and the run stats
+    if rl.IsKeyPressed(rl.KeyTab) {
+        c.model.ShowStats = !c.model.ShowStats
+    }
+    
+    // Check for continue from the last checkpoint; a boss level switches to its intro
+    if c.model.CanContinue && rl.IsKeyPressed(rl.KeyC) {
+        *c.currentState = constants.StateGame
+        c.continueGame()
+        return true
+    }
+    
     // Check for restart
     if rl.IsKeyPressed(rl.KeyR) {
         c.resetGame()
@@ -118,6 +146,28 @@ func (c *GameOverController) HandleInput() bool {
     return false
 }
 
+// handleNameEntry types the player's name for the leaderboard and submits it with Enter
+func (c *GameOverController) handleNameEntry() {
+    for char := rl.GetCharPressed(); char > 0; char = rl.GetCharPressed() {
+        if char >= 32 && char < 127 && len(c.model.PlayerName) < c.model.MaxNameLength {
+            c.model.PlayerName += string(rune(char))
+        }
+    }
+    
+    if rl.IsKeyPressed(rl.KeyBackspace) && len(c.model.PlayerName) > 0 {
+        c.model.PlayerName = c.model.PlayerName[:len(c.model.PlayerName)-1]
+    }
+    
+    if rl.IsKeyPressed(rl.KeyEnter) {
+        name := strings.TrimSpace(c.model.PlayerName)
+        if name == "" {
+            name = "PLAYER"
+        }
+        c.model.Rank = c.submitScore(name)
+        c.model.EnteringName = false
+    }
+}
+
 // ui/controllers/boss_intro_controller.go
 package controllers
 
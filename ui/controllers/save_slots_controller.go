@@ -0,0 +1,68 @@
+// ui/controllers/save_slots_controller.go
+package controllers
+
+import (
+    "atomblaster/constants"
+    "atomblaster/ui"
+    "atomblaster/ui/models"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SaveSlotsController handles input for the save slot screen
+type SaveSlotsController struct {
+    model        *models.SaveSlotsModel
+    currentState *int
+    loadGame     func(slot int) error
+}
+
+// NewSaveSlotsController creates a new save slot screen controller
+func NewSaveSlotsController(model *models.SaveSlotsModel, currentState *int, loadGame func(slot int) error) *SaveSlotsController {
+    return &SaveSlotsController{
+        model:        model,
+        currentState: currentState,
+        loadGame:     loadGame,
+    }
+}
+
+// SetModel sets the controller's data model
+func (c *SaveSlotsController) SetModel(model ui.Model) {
+    c.model = model.(*models.SaveSlotsModel)
+}
+
+// HandleInput processes input for the save slot screen
+func (c *SaveSlotsController) HandleInput() bool {
+    // Handle slot navigation
+    if rl.IsKeyPressed(rl.KeyUp) || rl.IsKeyPressed(rl.KeyW) {
+        c.model.SelectPreviousItem()
+        c.model.Message = ""
+    }
+    
+    if rl.IsKeyPressed(rl.KeyDown) || rl.IsKeyPressed(rl.KeyS) {
+        c.model.SelectNextItem()
+        c.model.Message = ""
+    }
+    
+    // Load the selected slot; a boss level switches to its intro while loading
+    if rl.IsKeyPressed(rl.KeyEnter) || rl.IsKeyPressed(rl.KeySpace) {
+        if !c.model.Slots[c.model.SelectedItem].Used {
+            return false
+        }
+        
+        *c.currentState = constants.StateGame
+        if err := c.loadGame(c.model.SelectedItem); err != nil {
+            c.model.Message = err.Error()
+            *c.currentState = constants.StateSaveSlots
+            return false
+        }
+        return true
+    }
+    
+    // Back to the title menu
+    if rl.IsKeyPressed(rl.KeyEscape) || rl.IsKeyPressed(rl.KeyBackspace) {
+        c.model.Message = ""
+        *c.currentState = constants.StateTitle
+        return true
+    }
+    
+    return false
+}
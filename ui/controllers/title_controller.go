@@ -10,15 +10,27 @@ import (
 
 // TitleController handles input for the title screen
 type TitleController struct {
-    model        *models.TitleModel
-    currentState *int
+    model           *models.TitleModel
+    currentState    *int
+    newCampaign     func()
+    cycleDifficulty func(step int) string // Selects the next or previous preset and returns its name
+    toggleAdaptive  func() bool           // Turns the difficulty director on or off and returns its state
 }
 
 // NewTitleController creates a new title screen controller
-func NewTitleController(model *models.TitleModel, currentState *int) *TitleController {
+func NewTitleController(
+    model *models.TitleModel,
+    currentState *int,
+    newCampaign func(),
+    cycleDifficulty func(step int) string,
+    toggleAdaptive func() bool,
+) *TitleController {
     return &TitleController{
-        model:        model,
-        currentState: currentState,
+        model:           model,
+        currentState:    currentState,
+        newCampaign:     newCampaign,
+        cycleDifficulty: cycleDifficulty,
+        toggleAdaptive:  toggleAdaptive,
     }
 }
 
@@ -38,6 +50,16 @@ func (c *TitleController) HandleInput() bool {
         c.model.SelectNextItem()
     }
     
+    // Left and right change the difficulty while it is selected
+    if c.model.GetSelectedOption() == "Difficulty" {
+        if rl.IsKeyPressed(rl.KeyLeft) || rl.IsKeyPressed(rl.KeyA) {
+            c.model.DifficultyName = c.cycleDifficulty(-1)
+        }
+        if rl.IsKeyPressed(rl.KeyRight) || rl.IsKeyPressed(rl.KeyD) {
+            c.model.DifficultyName = c.cycleDifficulty(1)
+        }
+    }
+    
     // Handle menu selection
     if rl.IsKeyPressed(rl.KeyEnter) || rl.IsKeyPressed(rl.KeySpace) {
         selectedOption := c.model.GetSelectedOption()
@@ -45,6 +67,30 @@ func (c *TitleController) HandleInput() bool {
         switch selectedOption {
         case "Start Game":
             *c.currentState = constants.StateGame
+            c.newCampaign()
+            return true
+            
+        case "Continue":
+            if !c.model.IsEnabled(selectedOption) {
+                return false
+            }
+            *c.currentState = constants.StateSaveSlots
+            return true
+            
+        case "Difficulty":
+            c.model.DifficultyName = c.cycleDifficulty(1)
+            return false
+            
+        case "Adaptive":
+            c.model.Adaptive = c.toggleAdaptive()
+            return false
+            
+        case "High Scores":
+            *c.currentState = constants.StateHighScores
+            return true
+            
+        case "Achievements":
+            *c.currentState = constants.StateAchievements
             return true
             
         case "Instructions":
@@ -0,0 +1,42 @@
+// ui/models/achievements_model.go
+package models
+
+import (
+    "time"
+
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AchievementInfo is one achievement as shown in the gallery
+type AchievementInfo struct {
+    Name        string
+    Description string
+    Progress    int
+    Goal        int
+    Unlocked    bool
+    Date        time.Time // When it was earned
+}
+
+// AchievementsModel contains data for the achievements gallery
+type AchievementsModel struct {
+    Background   rl.Texture2D
+    Achievements []AchievementInfo
+}
+
+// NewAchievementsModel creates a new achievements gallery model
+func NewAchievementsModel(background rl.Texture2D) *AchievementsModel {
+    return &AchievementsModel{
+        Background: background,
+    }
+}
+
+// UnlockedCount returns how many achievements have been earned
+func (m *AchievementsModel) UnlockedCount() int {
+    count := 0
+    for _, achievement := range m.Achievements {
+        if achievement.Unlocked {
+            count++
+        }
+    }
+    return count
+}
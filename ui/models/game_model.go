@@ -5,6 +5,15 @@ import (
     rl "github.com/gen2brain/raylib-go/raylib"
 )
 
+// EffectTimer is a running timed effect as shown in the HUD
+type EffectTimer struct {
+    Name      string
+    Remaining float32
+    Duration  float32
+    Stacks    int
+    Color     rl.Color
+}
+
 // GameModel contains data for the game screen
 type GameModel struct {
     Background        rl.Texture2D
@@ -19,6 +28,11 @@ type GameModel struct {
     TotalScientists   *int
     StartTime         *int64
     ElapsedTime       *int64
+    RadiationDose     *float32
+    WeaponName        *string
+    Effects           *[]EffectTimer
+    Lives             *int
+    Combo             *float32
 }
 
 // NewGameModel creates a new game screen model
@@ -27,6 +41,11 @@ func NewGameModel(
     powerUpSprites [3]rl.Texture2D,
     score, health, level, scientistsRescued, totalScientists *int,
     startTime, elapsedTime *int64,
+    radiationDose *float32,
+    weaponName *string,
+    effects *[]EffectTimer,
+    lives *int,
+    combo *float32,
 ) *GameModel {
     return &GameModel{
         Background:        background,
@@ -41,5 +60,10 @@ func NewGameModel(
         TotalScientists:   totalScientists,
         StartTime:         startTime,
         ElapsedTime:       elapsedTime,
+        RadiationDose:     radiationDose,
+        WeaponName:        weaponName,
+        Effects:           effects,
+        Lives:             lives,
+        Combo:             combo,
     }
 }
@@ -0,0 +1,22 @@
+// ui/models/high_scores_model.go
+package models
+
+import (
+    "atomblaster/storage"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// HighScoresModel contains data for the high scores screen
+type HighScoresModel struct {
+    Background rl.Texture2D
+    Table      *storage.HighScoreTable
+    Highlight  int // 1-based rank of the latest entry to highlight (0 = none)
+}
+
+// NewHighScoresModel creates a new high scores screen model
+func NewHighScoresModel(background rl.Texture2D, table *storage.HighScoreTable) *HighScoresModel {
+    return &HighScoresModel{
+        Background: background,
+        Table:      table,
+    }
+}
@@ -44,6 +44,28 @@ type GameOverModel struct {
     TimeElapsed    int64
     Scientists     int
     TotalScientists int
+    CanContinue    bool // Whether the run can continue from its last checkpoint
+    ContinuePenalty int // Points lost by continuing
+    Breakdown      []ScoreLine // Where the final score came from
+    EnteringName   bool   // Whether the score made the leaderboard and a name is being typed
+    PlayerName     string // Name typed so far
+    MaxNameLength  int
+    Rank           int    // Leaderboard rank of the submitted score (0 = not on the board)
+    Stats          []StatLine // Run statistics
+    ShowStats      bool       // Whether the stats are shown in place of the score breakdown
+    Unranked       bool       // Whether the run used adaptive difficulty and can't go on the leaderboard
+}
+
+// ScoreLine is one part of a score breakdown
+type ScoreLine struct {
+    Label  string
+    Points int
+}
+
+// StatLine is one entry of the run statistics
+type StatLine struct {
+    Label string
+    Value string
 }
 
 // NewGameOverModel creates a new game over screen model
@@ -62,6 +84,15 @@ func NewGameOverModel(
     }
 }
 
+// Refresh copies the final results of the run from the game model
+func (m *GameOverModel) Refresh() {
+    m.FinalScore = *m.GameModel.Score
+    m.LevelsComplete = *m.GameModel.Level
+    m.TimeElapsed = *m.GameModel.ElapsedTime
+    m.Scientists = *m.GameModel.ScientistsRescued
+    m.TotalScientists = *m.GameModel.TotalScientists
+}
+
 // ui/models/boss_intro_model.go
 package models
 
@@ -89,6 +120,12 @@ func NewBossIntroModel(background, playerSprite, bossSprite rl.Texture2D) *BossI
     }
 }
 
+// Reset restarts the boss intro animation
+func (m *BossIntroModel) Reset() {
+    m.Timer = 0
+    m.Alpha = 0
+}
+
 // Update advances the boss intro animation
 func (m *BossIntroModel) Update(dt float32) {
     m.Timer += dt
@@ -0,0 +1,47 @@
+// ui/models/save_slots_model.go
+package models
+
+import (
+    "time"
+
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SaveSlotInfo summarizes one save slot for the slot list
+type SaveSlotInfo struct {
+    Used       bool
+    Level      int
+    Score      int
+    Date       time.Time
+    Difficulty string
+    Error      string // Why a slot that has a file couldn't be read
+}
+
+// SaveSlotsModel contains data for the save slot screen
+type SaveSlotsModel struct {
+    Background   rl.Texture2D
+    Slots        []SaveSlotInfo
+    SelectedItem int
+    Message      string // Shown when a save fails to load
+}
+
+// NewSaveSlotsModel creates a new save slot screen model
+func NewSaveSlotsModel(background rl.Texture2D) *SaveSlotsModel {
+    return &SaveSlotsModel{
+        Background: background,
+    }
+}
+
+// SelectNextItem moves the selection to the next slot
+func (m *SaveSlotsModel) SelectNextItem() {
+    if len(m.Slots) > 0 {
+        m.SelectedItem = (m.SelectedItem + 1) % len(m.Slots)
+    }
+}
+
+// SelectPreviousItem moves the selection to the previous slot
+func (m *SaveSlotsModel) SelectPreviousItem() {
+    if len(m.Slots) > 0 {
+        m.SelectedItem = (m.SelectedItem - 1 + len(m.Slots)) % len(m.Slots)
+    }
+}
@@ -7,16 +7,19 @@ import (
 
 // TitleModel contains data for the title screen
 type TitleModel struct {
-    Background   rl.Texture2D
-    MenuOptions  []string
-    SelectedItem int
+    Background     rl.Texture2D
+    MenuOptions    []string
+    SelectedItem   int
+    HasSaves       bool   // Whether there is a saved campaign to continue
+    DifficultyName string // Difficulty a new campaign starts on
+    Adaptive       bool   // Whether new campaigns use the difficulty director
 }
 
 // NewTitleModel creates a new title screen model
 func NewTitleModel(background rl.Texture2D) *TitleModel {
     return &TitleModel{
         Background:   background,
-        MenuOptions:  []string{"Start Game", "Instructions", "Exit"},
+        MenuOptions:  []string{"Start Game", "Continue", "Difficulty", "Adaptive", "High Scores", "Achievements", "Instructions", "Exit"},
         SelectedItem: 0,
     }
 }
@@ -31,6 +34,25 @@ func (m *TitleModel) SelectPreviousItem() {
     m.SelectedItem = (m.SelectedItem - 1 + len(m.MenuOptions)) % len(m.MenuOptions)
 }
 
+// IsEnabled reports whether a menu option can currently be chosen
+func (m *TitleModel) IsEnabled(option string) bool {
+    return option != "Continue" || m.HasSaves
+}
+
+// OptionLabel returns the text shown for a menu option
+func (m *TitleModel) OptionLabel(option string) string {
+    switch option {
+    case "Difficulty":
+        return "Difficulty: " + m.DifficultyName
+    case "Adaptive":
+        if m.Adaptive {
+            return "Adaptive: On (unranked)"
+        }
+        return "Adaptive: Off"
+    }
+    return option
+}
+
 // GetSelectedOption returns the currently selected menu option
 func (m *TitleModel) GetSelectedOption() string {
     return m.MenuOptions[m.SelectedItem]
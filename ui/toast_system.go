@@ -0,0 +1,90 @@
+// ui/toast_system.go
+package ui
+
+import (
+    "atomblaster/constants"
+
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Toast is a short notification that slides in at the top of the screen
+type Toast struct {
+    Title     string
+    Text      string
+    StartTime float32
+    Duration  float32
+    Alpha     float32
+    Offset    float32 // How far the toast is still tucked above the screen edge
+}
+
+// ToastSystem shows toasts one at a time, in the order they were added
+type ToastSystem struct {
+    Toasts []Toast
+}
+
+const (
+    toastWidth     = 320
+    toastHeight    = 60
+    toastSlideTime = 0.3
+)
+
+func NewToastSystem() *ToastSystem {
+    return &ToastSystem{Toasts: []Toast{}}
+}
+
+// AddToast queues a toast; it starts once the ones before it have finished
+func (ts *ToastSystem) AddToast(title, text string, duration float32) {
+    ts.Toasts = append(ts.Toasts, Toast{
+        Title:    title,
+        Text:     text,
+        Duration: duration,
+        Offset:   toastHeight,
+    })
+}
+
+func (ts *ToastSystem) Update() {
+    if len(ts.Toasts) == 0 {
+        return
+    }
+
+    currentTime := float32(rl.GetTime())
+    toast := &ts.Toasts[0]
+    if toast.StartTime == 0 {
+        toast.StartTime = currentTime
+    }
+
+    elapsed := currentTime - toast.StartTime
+    if elapsed >= toast.Duration {
+        ts.Toasts = ts.Toasts[1:]
+        return
+    }
+
+    // Slide in, hold, then fade out
+    remaining := toast.Duration - elapsed
+    toast.Alpha = 1.0
+    if remaining < 0.5 {
+        toast.Alpha = remaining * 2.0
+    }
+    toast.Offset = 0
+    if elapsed < toastSlideTime {
+        toast.Offset = toastHeight * (1 - elapsed/toastSlideTime)
+    }
+}
+
+func (ts *ToastSystem) Draw() {
+    if len(ts.Toasts) == 0 || ts.Toasts[0].StartTime == 0 {
+        return
+    }
+
+    toast := ts.Toasts[0]
+    x := int32(constants.ScreenWidth - toastWidth - 10)
+    y := int32(10 - toast.Offset)
+
+    background := rl.Fade(rl.DarkBlue, 0.85*toast.Alpha)
+    border := rl.Fade(rl.Gold, toast.Alpha)
+    rl.DrawRectangle(x, y, toastWidth, toastHeight, background)
+    rl.DrawRectangleLines(x, y, toastWidth, toastHeight, border)
+
+    rl.DrawText(toast.Title, x+10, y+8, 18, border)
+    rl.DrawText(toast.Text, x+10, y+32, 20, rl.Fade(rl.White, toast.Alpha))
+}
@@ -0,0 +1,87 @@
+// ui/views/achievements_view.go
+package views
+
+import (
+    "atomblaster/constants"
+    "atomblaster/ui"
+    "atomblaster/ui/models"
+    "fmt"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// AchievementsView handles rendering the achievements gallery
+type AchievementsView struct {
+    model *models.AchievementsModel
+}
+
+// NewAchievementsView creates a new achievements gallery view
+func NewAchievementsView(model *models.AchievementsModel) *AchievementsView {
+    return &AchievementsView{
+        model: model,
+    }
+}
+
+// SetModel sets the view's data model
+func (v *AchievementsView) SetModel(model ui.Model) {
+    v.model = model.(*models.AchievementsModel)
+}
+
+// Draw renders the achievements gallery
+func (v *AchievementsView) Draw() {
+    // Draw background with an overlay so the list stays readable
+    rl.DrawTexture(v.model.Background, 0, 0, rl.White)
+    rl.DrawRectangle(0, 0, constants.ScreenWidth, constants.ScreenHeight, rl.Fade(rl.Black, 0.6))
+    
+    // Draw title with the unlocked count
+    titleText := fmt.Sprintf("ACHIEVEMENTS  %d/%d", v.model.UnlockedCount(), len(v.model.Achievements))
+    titleWidth := rl.MeasureText(titleText, 40)
+    rl.DrawText(
+        titleText,
+        int32(constants.ScreenWidth/2 - titleWidth/2),
+        30,
+        40,
+        rl.Gold,
+    )
+    
+    // Draw one row per achievement
+    rowX := int32(60)
+    rowWidth := int32(constants.ScreenWidth - 120)
+    for i, achievement := range v.model.Achievements {
+        rowY := int32(85 + i*46)
+        
+        nameColor := rl.Gray
+        if achievement.Unlocked {
+            nameColor = rl.Gold
+        }
+        rl.DrawRectangle(rowX, rowY, rowWidth, 42, rl.Fade(rl.DarkBlue, 0.4))
+        rl.DrawText(achievement.Name, rowX+10, rowY+4, 20, nameColor)
+        rl.DrawText(achievement.Description, rowX+10, rowY+25, 15, rl.LightGray)
+        
+        // Earned date, or a progress bar for achievements that take more than one step
+        status := ""
+        if achievement.Unlocked {
+            status = achievement.Date.Format("2006-01-02")
+        } else if achievement.Goal > 1 {
+            barWidth := int32(120)
+            barX := rowX + rowWidth - barWidth - 10
+            filled := barWidth * int32(achievement.Progress) / int32(achievement.Goal)
+            rl.DrawRectangle(barX, rowY+28, barWidth, 8, rl.DarkGray)
+            rl.DrawRectangle(barX, rowY+28, filled, 8, rl.SkyBlue)
+            status = fmt.Sprintf("%d/%d", achievement.Progress, achievement.Goal)
+        } else {
+            status = "Locked"
+        }
+        rl.DrawText(status, rowX+rowWidth-10-rl.MeasureText(status, 18), rowY+6, 18, nameColor)
+    }
+    
+    // Draw instruction
+    instructionText := "Press ENTER or ESC to return"
+    instructionWidth := rl.MeasureText(instructionText, 20)
+    rl.DrawText(
+        instructionText,
+        int32(constants.ScreenWidth/2 - instructionWidth/2),
+        int32(constants.ScreenHeight - 40),
+        20,
+        rl.White,
+    )
+}
@@ -2,6 +2,8 @@
 package views
 
 import (
+    "atomblaster/constants"
+    "atomblaster/systems"
     "atomblaster/ui"
     "atomblaster/ui/models"
     "fmt"
@@ -39,6 +41,17 @@ func (v *GameView) Draw() {
         rl.White,
     )
     
+    // Show the combo multiplier while a combo is going
+    if v.model.Combo != nil && *v.model.Combo > 1 {
+        rl.DrawText(
+            fmt.Sprintf("COMBO x%.1f", *v.model.Combo),
+            200,
+            10,
+            20,
+            rl.Gold,
+        )
+    }
+    
     rl.DrawText(
         fmt.Sprintf("HEALTH: %d", *v.model.Health),
         10,
@@ -47,6 +60,16 @@ func (v *GameView) Draw() {
         rl.White,
     )
     
+    if v.model.Lives != nil {
+        rl.DrawText(
+            fmt.Sprintf("LIVES: %d", *v.model.Lives),
+            160,
+            40,
+            20,
+            rl.White,
+        )
+    }
+    
     rl.DrawText(
         fmt.Sprintf("LEVEL: %d", *v.model.Level),
         10,
@@ -75,4 +98,89 @@ func (v *GameView) Draw() {
         20,
         rl.White,
     )
+    
+    // Draw the dosimeter
+    if v.model.RadiationDose != nil {
+        v.drawDosimeter(10, 160, *v.model.RadiationDose)
+    }
+    
+    // Draw the current weapon
+    if v.model.WeaponName != nil && *v.model.WeaponName != "" {
+        rl.DrawText(
+            fmt.Sprintf("WEAPON: %s", *v.model.WeaponName),
+            10,
+            190,
+            20,
+            rl.White,
+        )
+    }
+    
+    // Draw timers for running power-up effects
+    if v.model.Effects != nil {
+        v.drawEffectTimers(10, 220, *v.model.Effects)
+    }
+}
+
+// drawEffectTimers draws a shrinking bar for each running timed effect
+func (v *GameView) drawEffectTimers(x, y int32, effects []models.EffectTimer) {
+    barWidth := float32(150)
+    barHeight := float32(8)
+    
+    for i, effect := range effects {
+        rowY := y + int32(i)*24
+        
+        label := effect.Name
+        if effect.Stacks > 1 {
+            label = fmt.Sprintf("%s x%d", effect.Name, effect.Stacks)
+        }
+        rl.DrawText(label, x, rowY, 16, effect.Color)
+        
+        fill := float32(0)
+        if effect.Duration > 0 {
+            fill = effect.Remaining / effect.Duration
+        }
+        
+        // Blink during the last couple of seconds so expiry doesn't come as a surprise
+        color := effect.Color
+        if effect.Remaining < 2 && int(rl.GetTime()*6)%2 == 0 {
+            color = rl.White
+        }
+        
+        barX := x + 130
+        rl.DrawRectangle(barX, rowY+4, int32(barWidth), int32(barHeight), rl.DarkGray)
+        rl.DrawRectangle(barX, rowY+4, int32(barWidth*fill), int32(barHeight), color)
+        rl.DrawRectangleLines(barX, rowY+4, int32(barWidth), int32(barHeight), rl.LightGray)
+    }
+}
+
+// drawDosimeter draws the player's radiation dose as a bar with a tick at each sickness
+// threshold. The bar changes color with every threshold passed.
+func (v *GameView) drawDosimeter(x, y int32, dose float32) {
+    barWidth := float32(150)
+    barHeight := float32(12)
+    barX := x + 70
+    
+    rl.DrawText("DOSE", x, y, 20, rl.White)
+    
+    // Color by how many thresholds the dose has passed
+    colors := []rl.Color{rl.Green, rl.Yellow, rl.Orange, rl.Red}
+    level := systems.RadiationLevel(dose)
+    if level >= len(colors) {
+        level = len(colors) - 1
+    }
+    
+    fill := dose / constants.MaxRadiationDose
+    rl.DrawRectangle(barX, y+4, int32(barWidth), int32(barHeight), rl.DarkGray)
+    rl.DrawRectangle(barX, y+4, int32(barWidth*fill), int32(barHeight), colors[level])
+    rl.DrawRectangleLines(barX, y+4, int32(barWidth), int32(barHeight), rl.LightGray)
+    
+    for _, threshold := range constants.RadiationThresholds {
+        tickX := barX + int32(barWidth*threshold/constants.MaxRadiationDose)
+        rl.DrawLine(tickX, y+1, tickX, y+int32(barHeight)+7, rl.White)
+    }
+    
+    // Flash a warning while radiation sickness is draining health
+    if level > 0 && int(rl.GetTime()*4)%2 == 0 {
+        rl.DrawText("RADIATION SICKNESS", barX+int32(barWidth)+10, y, 20, colors[level])
+    }
 }
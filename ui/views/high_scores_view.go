@@ -0,0 +1,100 @@
+// ui/views/high_scores_view.go
+package views
+
+import (
+    "atomblaster/constants"
+    "atomblaster/ui"
+    "atomblaster/ui/models"
+    "fmt"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// HighScoresView handles rendering the high scores screen
+type HighScoresView struct {
+    model *models.HighScoresModel
+}
+
+// NewHighScoresView creates a new high scores screen view
+func NewHighScoresView(model *models.HighScoresModel) *HighScoresView {
+    return &HighScoresView{
+        model: model,
+    }
+}
+
+// SetModel sets the view's data model
+func (v *HighScoresView) SetModel(model ui.Model) {
+    v.model = model.(*models.HighScoresModel)
+}
+
+// Draw renders the high scores screen
+func (v *HighScoresView) Draw() {
+    // Draw background with an overlay so the table stays readable
+    rl.DrawTexture(v.model.Background, 0, 0, rl.White)
+    rl.DrawRectangle(0, 0, constants.ScreenWidth, constants.ScreenHeight, rl.Fade(rl.Black, 0.6))
+    
+    // Draw title
+    titleText := "HIGH SCORES"
+    titleWidth := rl.MeasureText(titleText, 50)
+    rl.DrawText(
+        titleText,
+        int32(constants.ScreenWidth/2 - titleWidth/2),
+        40,
+        50,
+        rl.Gold,
+    )
+    
+    // Column positions
+    columns := []int32{40, 90, 260, 370, 440, 530, 620, 700}
+    headers := []string{"#", "NAME", "SCORE", "LEVEL", "TIME", "RESCUE", "MODE", "DATE"}
+    headerY := int32(120)
+    for i, header := range headers {
+        rl.DrawText(header, columns[i], headerY, 20, rl.LightGray)
+    }
+    
+    entries := v.model.Table.Entries
+    if len(entries) == 0 {
+        emptyText := "No scores yet - go set one!"
+        emptyWidth := rl.MeasureText(emptyText, 25)
+        rl.DrawText(
+            emptyText,
+            int32(constants.ScreenWidth/2 - emptyWidth/2),
+            250,
+            25,
+            rl.White,
+        )
+    }
+    
+    // Draw one row per entry
+    for i, entry := range entries {
+        rowY := headerY + 40 + int32(i)*32
+        color := rl.White
+        if i+1 == v.model.Highlight {
+            color = rl.Yellow
+        }
+        
+        fields := []string{
+            fmt.Sprintf("%d", i+1),
+            entry.Name,
+            fmt.Sprintf("%d", entry.Score),
+            fmt.Sprintf("%d", entry.Level),
+            fmt.Sprintf("%02d:%02d", entry.TimeSeconds/60, entry.TimeSeconds%60),
+            fmt.Sprintf("%d%%", entry.RescueRate),
+            entry.Difficulty,
+            entry.Date.Format("01/02"),
+        }
+        for j, field := range fields {
+            rl.DrawText(field, columns[j], rowY, 20, color)
+        }
+    }
+    
+    // Draw instruction
+    instructionText := "Press ENTER or ESC to return"
+    instructionWidth := rl.MeasureText(instructionText, 20)
+    rl.DrawText(
+        instructionText,
+        int32(constants.ScreenWidth/2 - instructionWidth/2),
+        int32(constants.ScreenHeight - 50),
+        20,
+        rl.White,
+    )
+}
@@ -215,8 +215,63 @@ func (v *GameOverView) Draw() {
         rl.White,
     )
     
+    // Score breakdown or run stats, in two columns
+    breakdownY := baseY + 4*lineSpacing + 2
+    heading := "SCORE BREAKDOWN  (TAB: run stats)"
+    if v.model.ShowStats {
+        heading = "RUN STATS  (TAB: score breakdown)"
+    }
+    headingWidth := rl.MeasureText(heading, 16)
+    rl.DrawText(heading, int32(constants.ScreenWidth/2-headingWidth/2), int32(breakdownY), 16, rl.Gray)
+    
+    if v.model.ShowStats {
+        for i, line := range v.model.Stats {
+            v.drawColumnLine(i, breakdownY+20, line.Label, line.Value, rl.LightGray)
+        }
+    } else {
+        for i, line := range v.model.Breakdown {
+            color := rl.LightGray
+            if line.Points < 0 {
+                color = rl.Red
+            }
+            v.drawColumnLine(i, breakdownY+20, line.Label, fmt.Sprintf("%+d", line.Points), color)
+        }
+    }
+    
+    // A qualifying score asks for a name instead of the usual options
+    if v.model.EnteringName {
+        v.drawNameEntry()
+        return
+    }
+    
+    // Leaderboard rank of the submitted score
+    if v.model.Rank > 0 {
+        rankText := fmt.Sprintf("High Score #%d!", v.model.Rank)
+        rankWidth := rl.MeasureText(rankText, 30)
+        rl.DrawText(
+            rankText,
+            int32(constants.ScreenWidth/2 - rankWidth/2),
+            int32(constants.ScreenHeight - 130),
+            30,
+            rl.Gold,
+        )
+    } else if v.model.Unranked {
+        unrankedText := "Adaptive difficulty run - not ranked"
+        unrankedWidth := rl.MeasureText(unrankedText, 20)
+        rl.DrawText(
+            unrankedText,
+            int32(constants.ScreenWidth/2 - unrankedWidth/2),
+            int32(constants.ScreenHeight - 120),
+            20,
+            rl.Gray,
+        )
+    }
+    
     // Draw restart instruction
     restartText := "Press R to Restart, Q to Quit"
+    if v.model.CanContinue {
+        restartText = fmt.Sprintf("Press C to Continue (-%d points), R to Restart, Q to Quit", v.model.ContinuePenalty)
+    }
     restartWidth := rl.MeasureText(restartText, 25)
     rl.DrawText(
         restartText,
@@ -226,3 +281,54 @@ func (v *GameOverView) Draw() {
         rl.White,
     )
 }
+
+// drawNameEntry draws the leaderboard name prompt with a blinking cursor
+func (v *GameOverView) drawNameEntry() {
+    promptText := "NEW HIGH SCORE! Enter your name:"
+    promptWidth := rl.MeasureText(promptText, 30)
+    rl.DrawText(
+        promptText,
+        int32(constants.ScreenWidth/2 - promptWidth/2),
+        int32(constants.ScreenHeight - 170),
+        30,
+        rl.Gold,
+    )
+    
+    name := v.model.PlayerName
+    if int(rl.GetTime()*2)%2 == 0 && len(name) < v.model.MaxNameLength {
+        name += "_"
+    }
+    nameWidth := rl.MeasureText(v.model.PlayerName+"_", 40)
+    rl.DrawText(
+        name,
+        int32(constants.ScreenWidth/2 - nameWidth/2),
+        int32(constants.ScreenHeight - 125),
+        40,
+        rl.White,
+    )
+    
+    hintText := "Press ENTER to save"
+    hintWidth := rl.MeasureText(hintText, 20)
+    rl.DrawText(
+        hintText,
+        int32(constants.ScreenWidth/2 - hintWidth/2),
+        int32(constants.ScreenHeight - 70),
+        20,
+        rl.LightGray,
+    )
+}
+
+// drawColumnLine draws the i-th line of a two column list: a label on the left and
+// its value right-aligned, with five lines to a column
+func (v *GameOverView) drawColumnLine(i, top int, label, value string, color rl.Color) {
+    column := int32(constants.ScreenWidth/2 - 280)
+    row := i
+    if i >= 5 {
+        column = int32(constants.ScreenWidth/2 + 20)
+        row = i - 5
+    }
+    
+    rowY := int32(top + row*22)
+    rl.DrawText(label, column, rowY, 20, color)
+    rl.DrawText(value, column+260-rl.MeasureText(value, 20), rowY, 20, color)
+}
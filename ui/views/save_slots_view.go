@@ -0,0 +1,97 @@
+// ui/views/save_slots_view.go
+package views
+
+import (
+    "atomblaster/constants"
+    "atomblaster/ui"
+    "atomblaster/ui/models"
+    "fmt"
+    rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// SaveSlotsView handles rendering the save slot screen
+type SaveSlotsView struct {
+    model *models.SaveSlotsModel
+}
+
+// NewSaveSlotsView creates a new save slot screen view
+func NewSaveSlotsView(model *models.SaveSlotsModel) *SaveSlotsView {
+    return &SaveSlotsView{
+        model: model,
+    }
+}
+
+// SetModel sets the view's data model
+func (v *SaveSlotsView) SetModel(model ui.Model) {
+    v.model = model.(*models.SaveSlotsModel)
+}
+
+// Draw renders the save slot screen
+func (v *SaveSlotsView) Draw() {
+    // Draw background with an overlay so the slots stay readable
+    rl.DrawTexture(v.model.Background, 0, 0, rl.White)
+    rl.DrawRectangle(0, 0, constants.ScreenWidth, constants.ScreenHeight, rl.Fade(rl.Black, 0.6))
+    
+    // Draw title
+    titleText := "CONTINUE"
+    titleWidth := rl.MeasureText(titleText, 50)
+    rl.DrawText(
+        titleText,
+        int32(constants.ScreenWidth/2 - titleWidth/2),
+        60,
+        50,
+        rl.White,
+    )
+    
+    // Draw one box per slot
+    boxWidth := int32(500)
+    boxHeight := int32(80)
+    boxX := int32(constants.ScreenWidth/2) - boxWidth/2
+    for i, slot := range v.model.Slots {
+        boxY := int32(160 + i*100)
+        
+        borderColor := rl.Gray
+        if i == v.model.SelectedItem {
+            borderColor = rl.Yellow
+        }
+        rl.DrawRectangle(boxX, boxY, boxWidth, boxHeight, rl.Fade(rl.DarkBlue, 0.5))
+        rl.DrawRectangleLines(boxX, boxY, boxWidth, boxHeight, borderColor)
+        
+        rl.DrawText(fmt.Sprintf("SLOT %d", i+1), boxX+15, boxY+10, 20, borderColor)
+        
+        switch {
+        case slot.Used:
+            details := fmt.Sprintf("Level %d    Score %d    %s", slot.Level, slot.Score, slot.Difficulty)
+            rl.DrawText(details, boxX+15, boxY+40, 25, rl.White)
+            date := slot.Date.Format("2006-01-02 15:04")
+            rl.DrawText(date, boxX+boxWidth-15-rl.MeasureText(date, 20), boxY+10, 20, rl.LightGray)
+        case slot.Error != "":
+            rl.DrawText("Unreadable save", boxX+15, boxY+40, 25, rl.Red)
+        default:
+            rl.DrawText("Empty", boxX+15, boxY+40, 25, rl.DarkGray)
+        }
+    }
+    
+    // Draw the last load error
+    if v.model.Message != "" {
+        messageWidth := rl.MeasureText(v.model.Message, 20)
+        rl.DrawText(
+            v.model.Message,
+            int32(constants.ScreenWidth/2 - messageWidth/2),
+            int32(constants.ScreenHeight - 90),
+            20,
+            rl.Red,
+        )
+    }
+    
+    // Draw instruction
+    instructionText := "Enter to Load, Esc or Backspace to go Back"
+    instructionWidth := rl.MeasureText(instructionText, 20)
+    rl.DrawText(
+        instructionText,
+        int32(constants.ScreenWidth/2 - instructionWidth/2),
+        int32(constants.ScreenHeight - 50),
+        20,
+        rl.White,
+    )
+}
@@ -44,8 +44,8 @@ func (v *TitleView) Draw() {
     )
     
     // Draw menu options
-    menuY := 250
-    menuSpacing := 60
+    menuY := 180
+    menuSpacing := 40
     
     for i, option := range v.model.MenuOptions {
         fontSize := 30
@@ -60,19 +60,25 @@ func (v *TitleView) Draw() {
             )
             
             rl.DrawText(
-                option,
+                v.model.OptionLabel(option),
                 int32(constants.ScreenWidth/2 - 80),
                 int32(menuY + i*menuSpacing),
                 int32(fontSize),
                 rl.Yellow,
             )
         } else {
+            // Options that can't be chosen yet are greyed out
+            color := rl.White
+            if !v.model.IsEnabled(option) {
+                color = rl.DarkGray
+            }
+            
             rl.DrawText(
-                option,
+                v.model.OptionLabel(option),
                 int32(constants.ScreenWidth/2 - 80),
                 int32(menuY + i*menuSpacing),
                 int32(fontSize),
-                rl.White,
+                color,
             )
         }
     }
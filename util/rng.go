@@ -0,0 +1,109 @@
+// util/rng.go
+package util
+
+import (
+    "math/rand"
+)
+
+// RNG is a seeded random number generator, so a run can be reproduced from its seed
+type RNG struct {
+    seed int64
+    src  *splitMixSource
+    r    *rand.Rand
+}
+
+// RNGState is a generator's position, which can be saved and restored later
+type RNGState struct {
+    Seed  int64  `json:"seed"`
+    State uint64 `json:"state"` // Internal state of the source
+}
+
+// splitMixSource is a SplitMix64 rand source. Its whole state is one number, so the
+// generator's position can be saved and restored without replaying any draws.
+type splitMixSource struct {
+    state uint64
+}
+
+func (s *splitMixSource) Uint64() uint64 {
+    s.state += 0x9e3779b97f4a7c15
+    z := s.state
+    z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+    z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+    return z ^ (z >> 31)
+}
+
+func (s *splitMixSource) Int63() int64 {
+    return int64(s.Uint64() >> 1)
+}
+
+func (s *splitMixSource) Seed(seed int64) {
+    s.state = uint64(seed)
+}
+
+// NewRNG creates a random number generator with the given seed
+func NewRNG(seed int64) *RNG {
+    src := &splitMixSource{state: uint64(seed)}
+    return &RNG{
+        seed: seed,
+        src:  src,
+        r:    rand.New(src),
+    }
+}
+
+// Seed returns the seed the generator was last seeded with
+func (g *RNG) Seed() int64 {
+    return g.seed
+}
+
+// Reseed restarts the generator from a new seed
+func (g *RNG) Reseed(seed int64) {
+    g.seed = seed
+    g.r.Seed(seed)
+}
+
+// State returns the generator's current position
+func (g *RNG) State() RNGState {
+    return RNGState{Seed: g.seed, State: g.src.state}
+}
+
+// Restore moves the generator back to a position returned by State
+func (g *RNG) Restore(state RNGState) {
+    g.seed = state.Seed
+    g.src.state = state.State
+}
+
+// Float32 returns a random number in [0, 1)
+func (g *RNG) Float32() float32 {
+    return g.r.Float32()
+}
+
+// Range returns a random number in [min, max)
+func (g *RNG) Range(min, max float32) float32 {
+    return min + g.r.Float32()*(max-min)
+}
+
+// Intn returns a random integer in [0, n)
+func (g *RNG) Intn(n int) int {
+    if n <= 0 {
+        return 0
+    }
+    return g.r.Intn(n)
+}
+
+// IntRange returns a random integer in [min, max]
+func (g *RNG) IntRange(min, max int) int {
+    if max <= min {
+        return min
+    }
+    return min + g.r.Intn(max-min+1)
+}
+
+// Chance returns true with the given probability (0 to 1)
+func (g *RNG) Chance(probability float32) bool {
+    return g.r.Float32() < probability
+}
+
+// ExpFloat32 returns an exponentially distributed number with mean 1
+func (g *RNG) ExpFloat32() float32 {
+    return float32(g.r.ExpFloat64())
+}